@@ -0,0 +1,53 @@
+// cmd/api/controllers/brand_controller.go
+
+package controllers
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/commands/deactivate_brand"
+	"car-service/internal/application/commands/delete_brand"
+	"car-service/internal/application/commands/new_brand"
+	"car-service/internal/application/commands/update_brand"
+	"car-service/internal/application/queries/get_brand"
+	"car-service/internal/application/queries/get_brand_models"
+	"car-service/internal/application/queries/get_brands"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BrandController struct {
+	mediator *ginadapter.Adapter
+}
+
+func NewBrandController(mediator *ginadapter.Adapter) *BrandController {
+	return &BrandController{mediator: mediator}
+}
+
+func (h *BrandController) CreateBrand(c *gin.Context) {
+	h.mediator.Send(c, api.Command, new_brand.Name, new(new_brand.NewBrandRequest))
+}
+
+func (h *BrandController) GetBrands(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_brands.Name, new(get_brands.GetBrandsRequest))
+}
+
+func (h *BrandController) GetBrand(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_brand.Name, new(get_brand.GetBrandRequest))
+}
+
+func (h *BrandController) UpdateBrand(c *gin.Context) {
+	h.mediator.Send(c, api.Command, update_brand.Name, new(update_brand.UpdateBrandRequest))
+}
+
+func (h *BrandController) DeleteBrand(c *gin.Context) {
+	h.mediator.Send(c, api.Command, delete_brand.Name, new(delete_brand.DeleteBrandRequest))
+}
+
+func (h *BrandController) DeactivateBrand(c *gin.Context) {
+	h.mediator.Send(c, api.Command, deactivate_brand.Name, new(deactivate_brand.DeactivateBrandRequest))
+}
+
+func (h *BrandController) GetBrandModels(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_brand_models.Name, new(get_brand_models.GetBrandModelsRequest))
+}
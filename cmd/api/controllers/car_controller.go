@@ -3,18 +3,27 @@
 package controllers
 
 import (
+	"car-service/cmd/api/ginadapter"
 	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/commands/change_car_status"
+	"car-service/internal/application/commands/delete_car"
 	"car-service/internal/application/commands/new_car"
+	"car-service/internal/application/commands/patch_car"
+	"car-service/internal/application/commands/transfer_car_ownership"
+	"car-service/internal/application/commands/update_car"
+	"car-service/internal/application/queries/get_car"
+	"car-service/internal/application/queries/get_car_status_history"
 	"car-service/internal/application/queries/get_cars"
+	"car-service/internal/application/queries/get_ownership_history"
 
 	"github.com/gin-gonic/gin"
 )
 
 type CarController struct {
-	mediator *api.Mediator
+	mediator *ginadapter.Adapter
 }
 
-func NewCarController(mediator *api.Mediator) *CarController {
+func NewCarController(mediator *ginadapter.Adapter) *CarController {
 	return &CarController{mediator: mediator}
 }
 
@@ -23,5 +32,37 @@ func (h *CarController) CreateCar(c *gin.Context) {
 }
 
 func (h *CarController) GetCars(c *gin.Context) {
-	h.mediator.Send(c, api.Query, get_cars.Name, api.QueryRequest[any]{})
+	h.mediator.Send(c, api.Query, get_cars.Name, new(get_cars.GetCarsRequest))
+}
+
+func (h *CarController) GetCar(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_car.Name, new(get_car.GetCarRequest))
+}
+
+func (h *CarController) UpdateCar(c *gin.Context) {
+	h.mediator.Send(c, api.Command, update_car.Name, new(update_car.UpdateCarRequest))
+}
+
+func (h *CarController) PatchCar(c *gin.Context) {
+	h.mediator.Send(c, api.Command, patch_car.Name, new(patch_car.PatchCarRequest))
+}
+
+func (h *CarController) DeleteCar(c *gin.Context) {
+	h.mediator.Send(c, api.Command, delete_car.Name, new(delete_car.DeleteCarRequest))
+}
+
+func (h *CarController) TransferCarOwnership(c *gin.Context) {
+	h.mediator.Send(c, api.Command, transfer_car_ownership.Name, new(transfer_car_ownership.TransferCarOwnershipRequest))
+}
+
+func (h *CarController) GetOwnershipHistory(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_ownership_history.Name, new(get_ownership_history.GetOwnershipHistoryRequest))
+}
+
+func (h *CarController) ChangeCarStatus(c *gin.Context) {
+	h.mediator.Send(c, api.Command, change_car_status.Name, new(change_car_status.ChangeCarStatusRequest))
+}
+
+func (h *CarController) GetCarStatusHistory(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_car_status_history.Name, new(get_car_status_history.GetCarStatusHistoryRequest))
 }
@@ -0,0 +1,38 @@
+// cmd/api/controllers/inspection_controller.go
+
+package controllers
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/commands/new_inspection"
+	"car-service/internal/application/queries/get_car_inspections"
+	"car-service/internal/application/queries/get_due_inspections"
+	"car-service/internal/application/queries/get_inspection"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InspectionController struct {
+	mediator *ginadapter.Adapter
+}
+
+func NewInspectionController(mediator *ginadapter.Adapter) *InspectionController {
+	return &InspectionController{mediator: mediator}
+}
+
+func (h *InspectionController) CreateInspection(c *gin.Context) {
+	h.mediator.Send(c, api.Command, new_inspection.Name, new(new_inspection.NewInspectionRequest))
+}
+
+func (h *InspectionController) GetCarInspections(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_car_inspections.Name, new(get_car_inspections.GetCarInspectionsRequest))
+}
+
+func (h *InspectionController) GetInspection(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_inspection.Name, new(get_inspection.GetInspectionRequest))
+}
+
+func (h *InspectionController) GetDueInspections(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_due_inspections.Name, new(get_due_inspections.GetDueInspectionsRequest))
+}
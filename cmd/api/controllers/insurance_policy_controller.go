@@ -0,0 +1,43 @@
+// cmd/api/controllers/insurance_policy_controller.go
+
+package controllers
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/commands/new_insurance_policy"
+	"car-service/internal/application/commands/terminate_insurance_policy"
+	"car-service/internal/application/queries/get_car_insurance_policies"
+	"car-service/internal/application/queries/get_insurance_alerts"
+	"car-service/internal/application/queries/get_owner_insurance_policies"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InsurancePolicyController struct {
+	mediator *ginadapter.Adapter
+}
+
+func NewInsurancePolicyController(mediator *ginadapter.Adapter) *InsurancePolicyController {
+	return &InsurancePolicyController{mediator: mediator}
+}
+
+func (h *InsurancePolicyController) CreateInsurancePolicy(c *gin.Context) {
+	h.mediator.Send(c, api.Command, new_insurance_policy.Name, new(new_insurance_policy.NewInsurancePolicyRequest))
+}
+
+func (h *InsurancePolicyController) GetCarInsurancePolicies(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_car_insurance_policies.Name, new(get_car_insurance_policies.GetCarInsurancePoliciesRequest))
+}
+
+func (h *InsurancePolicyController) TerminateInsurancePolicy(c *gin.Context) {
+	h.mediator.Send(c, api.Command, terminate_insurance_policy.Name, new(terminate_insurance_policy.TerminateInsurancePolicyRequest))
+}
+
+func (h *InsurancePolicyController) GetOwnerInsurancePolicies(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_owner_insurance_policies.Name, new(get_owner_insurance_policies.GetOwnerInsurancePoliciesRequest))
+}
+
+func (h *InsurancePolicyController) GetInsuranceAlerts(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_insurance_alerts.Name, new(get_insurance_alerts.GetInsuranceAlertsRequest))
+}
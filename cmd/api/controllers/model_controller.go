@@ -0,0 +1,68 @@
+// cmd/api/controllers/model_controller.go
+
+package controllers
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/commands/delete_model"
+	"car-service/internal/application/commands/delete_model_trim"
+	"car-service/internal/application/commands/new_model"
+	"car-service/internal/application/commands/new_model_trim"
+	"car-service/internal/application/commands/update_model"
+	"car-service/internal/application/commands/update_model_trim"
+	"car-service/internal/application/queries/get_model"
+	"car-service/internal/application/queries/get_model_trim"
+	"car-service/internal/application/queries/get_model_trims"
+	"car-service/internal/application/queries/get_models"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ModelController struct {
+	mediator *ginadapter.Adapter
+}
+
+func NewModelController(mediator *ginadapter.Adapter) *ModelController {
+	return &ModelController{mediator: mediator}
+}
+
+func (h *ModelController) CreateModel(c *gin.Context) {
+	h.mediator.Send(c, api.Command, new_model.Name, new(new_model.NewModelRequest))
+}
+
+func (h *ModelController) GetModels(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_models.Name, new(get_models.GetModelsRequest))
+}
+
+func (h *ModelController) GetModel(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_model.Name, new(get_model.GetModelRequest))
+}
+
+func (h *ModelController) UpdateModel(c *gin.Context) {
+	h.mediator.Send(c, api.Command, update_model.Name, new(update_model.UpdateModelRequest))
+}
+
+func (h *ModelController) DeleteModel(c *gin.Context) {
+	h.mediator.Send(c, api.Command, delete_model.Name, new(delete_model.DeleteModelRequest))
+}
+
+func (h *ModelController) CreateModelTrim(c *gin.Context) {
+	h.mediator.Send(c, api.Command, new_model_trim.Name, new(new_model_trim.NewModelTrimRequest))
+}
+
+func (h *ModelController) GetModelTrims(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_model_trims.Name, new(get_model_trims.GetModelTrimsRequest))
+}
+
+func (h *ModelController) GetModelTrim(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_model_trim.Name, new(get_model_trim.GetModelTrimRequest))
+}
+
+func (h *ModelController) UpdateModelTrim(c *gin.Context) {
+	h.mediator.Send(c, api.Command, update_model_trim.Name, new(update_model_trim.UpdateModelTrimRequest))
+}
+
+func (h *ModelController) DeleteModelTrim(c *gin.Context) {
+	h.mediator.Send(c, api.Command, delete_model_trim.Name, new(delete_model_trim.DeleteModelTrimRequest))
+}
@@ -0,0 +1,28 @@
+// cmd/api/controllers/odometer_controller.go
+
+package controllers
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/commands/new_odometer_reading"
+	"car-service/internal/application/queries/get_odometer_history"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OdometerController struct {
+	mediator *ginadapter.Adapter
+}
+
+func NewOdometerController(mediator *ginadapter.Adapter) *OdometerController {
+	return &OdometerController{mediator: mediator}
+}
+
+func (h *OdometerController) CreateOdometerReading(c *gin.Context) {
+	h.mediator.Send(c, api.Command, new_odometer_reading.Name, new(new_odometer_reading.NewOdometerReadingRequest))
+}
+
+func (h *OdometerController) GetOdometerHistory(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_odometer_history.Name, new(get_odometer_history.GetOdometerHistoryRequest))
+}
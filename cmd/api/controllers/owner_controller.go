@@ -0,0 +1,48 @@
+// cmd/api/controllers/owner_controller.go
+
+package controllers
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/commands/delete_owner"
+	"car-service/internal/application/commands/new_owner"
+	"car-service/internal/application/commands/update_owner"
+	"car-service/internal/application/queries/get_owner"
+	"car-service/internal/application/queries/get_owner_cars"
+	"car-service/internal/application/queries/get_owners"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OwnerController struct {
+	mediator *ginadapter.Adapter
+}
+
+func NewOwnerController(mediator *ginadapter.Adapter) *OwnerController {
+	return &OwnerController{mediator: mediator}
+}
+
+func (h *OwnerController) CreateOwner(c *gin.Context) {
+	h.mediator.Send(c, api.Command, new_owner.Name, new(new_owner.NewOwnerRequest))
+}
+
+func (h *OwnerController) GetOwners(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_owners.Name, new(get_owners.GetOwnersRequest))
+}
+
+func (h *OwnerController) GetOwner(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_owner.Name, new(get_owner.GetOwnerRequest))
+}
+
+func (h *OwnerController) UpdateOwner(c *gin.Context) {
+	h.mediator.Send(c, api.Command, update_owner.Name, new(update_owner.UpdateOwnerRequest))
+}
+
+func (h *OwnerController) DeleteOwner(c *gin.Context) {
+	h.mediator.Send(c, api.Command, delete_owner.Name, new(delete_owner.DeleteOwnerRequest))
+}
+
+func (h *OwnerController) GetOwnerCars(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_owner_cars.Name, new(get_owner_cars.GetOwnerCarsRequest))
+}
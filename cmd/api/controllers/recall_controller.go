@@ -0,0 +1,43 @@
+// cmd/api/controllers/recall_controller.go
+
+package controllers
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/commands/complete_recall_remedy"
+	"car-service/internal/application/commands/new_recall"
+	"car-service/internal/application/queries/get_car_recalls"
+	"car-service/internal/application/queries/get_recall"
+	"car-service/internal/application/queries/get_recall_affected_cars"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecallController struct {
+	mediator *ginadapter.Adapter
+}
+
+func NewRecallController(mediator *ginadapter.Adapter) *RecallController {
+	return &RecallController{mediator: mediator}
+}
+
+func (h *RecallController) CreateRecall(c *gin.Context) {
+	h.mediator.Send(c, api.Command, new_recall.Name, new(new_recall.NewRecallRequest))
+}
+
+func (h *RecallController) GetRecall(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_recall.Name, new(get_recall.GetRecallRequest))
+}
+
+func (h *RecallController) GetRecallAffectedCars(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_recall_affected_cars.Name, new(get_recall_affected_cars.GetRecallAffectedCarsRequest))
+}
+
+func (h *RecallController) GetCarRecalls(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_car_recalls.Name, new(get_car_recalls.GetCarRecallsRequest))
+}
+
+func (h *RecallController) CompleteRecallRemedy(c *gin.Context) {
+	h.mediator.Send(c, api.Command, complete_recall_remedy.Name, new(complete_recall_remedy.CompleteRecallRemedyRequest))
+}
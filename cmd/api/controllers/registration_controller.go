@@ -0,0 +1,33 @@
+// cmd/api/controllers/registration_controller.go
+
+package controllers
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/commands/cancel_registration"
+	"car-service/internal/application/commands/new_registration"
+	"car-service/internal/application/queries/get_car_registrations"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RegistrationController struct {
+	mediator *ginadapter.Adapter
+}
+
+func NewRegistrationController(mediator *ginadapter.Adapter) *RegistrationController {
+	return &RegistrationController{mediator: mediator}
+}
+
+func (h *RegistrationController) CreateRegistration(c *gin.Context) {
+	h.mediator.Send(c, api.Command, new_registration.Name, new(new_registration.NewRegistrationRequest))
+}
+
+func (h *RegistrationController) GetCarRegistrations(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_car_registrations.Name, new(get_car_registrations.GetCarRegistrationsRequest))
+}
+
+func (h *RegistrationController) CancelRegistration(c *gin.Context) {
+	h.mediator.Send(c, api.Command, cancel_registration.Name, new(cancel_registration.CancelRegistrationRequest))
+}
@@ -0,0 +1,43 @@
+// cmd/api/controllers/service_record_controller.go
+
+package controllers
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/commands/delete_service_record"
+	"car-service/internal/application/commands/new_service_record"
+	"car-service/internal/application/commands/update_service_record"
+	"car-service/internal/application/queries/get_service_record"
+	"car-service/internal/application/queries/get_service_records"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ServiceRecordController struct {
+	mediator *ginadapter.Adapter
+}
+
+func NewServiceRecordController(mediator *ginadapter.Adapter) *ServiceRecordController {
+	return &ServiceRecordController{mediator: mediator}
+}
+
+func (h *ServiceRecordController) CreateServiceRecord(c *gin.Context) {
+	h.mediator.Send(c, api.Command, new_service_record.Name, new(new_service_record.NewServiceRecordRequest))
+}
+
+func (h *ServiceRecordController) GetServiceRecords(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_service_records.Name, new(get_service_records.GetServiceRecordsRequest))
+}
+
+func (h *ServiceRecordController) GetServiceRecord(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_service_record.Name, new(get_service_record.GetServiceRecordRequest))
+}
+
+func (h *ServiceRecordController) UpdateServiceRecord(c *gin.Context) {
+	h.mediator.Send(c, api.Command, update_service_record.Name, new(update_service_record.UpdateServiceRecordRequest))
+}
+
+func (h *ServiceRecordController) DeleteServiceRecord(c *gin.Context) {
+	h.mediator.Send(c, api.Command, delete_service_record.Name, new(delete_service_record.DeleteServiceRecordRequest))
+}
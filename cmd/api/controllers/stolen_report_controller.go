@@ -0,0 +1,38 @@
+// cmd/api/controllers/stolen_report_controller.go
+
+package controllers
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/commands/new_stolen_report"
+	"car-service/internal/application/commands/recover_stolen_report"
+	"car-service/internal/application/queries/get_car_stolen_reports"
+	"car-service/internal/application/queries/get_stolen_report"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StolenReportController struct {
+	mediator *ginadapter.Adapter
+}
+
+func NewStolenReportController(mediator *ginadapter.Adapter) *StolenReportController {
+	return &StolenReportController{mediator: mediator}
+}
+
+func (h *StolenReportController) CreateStolenReport(c *gin.Context) {
+	h.mediator.Send(c, api.Command, new_stolen_report.Name, new(new_stolen_report.NewStolenReportRequest))
+}
+
+func (h *StolenReportController) GetStolenReport(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_stolen_report.Name, new(get_stolen_report.GetStolenReportRequest))
+}
+
+func (h *StolenReportController) RecoverStolenReport(c *gin.Context) {
+	h.mediator.Send(c, api.Command, recover_stolen_report.Name, new(recover_stolen_report.RecoverStolenReportRequest))
+}
+
+func (h *StolenReportController) GetCarStolenReports(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_car_stolen_reports.Name, new(get_car_stolen_reports.GetCarStolenReportsRequest))
+}
@@ -0,0 +1,28 @@
+// cmd/api/controllers/vin_controller.go
+
+package controllers
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/queries/check_vin_watchlist"
+	"car-service/internal/application/queries/decode_vin"
+
+	"github.com/gin-gonic/gin"
+)
+
+type VinController struct {
+	mediator *ginadapter.Adapter
+}
+
+func NewVinController(mediator *ginadapter.Adapter) *VinController {
+	return &VinController{mediator: mediator}
+}
+
+func (h *VinController) DecodeVin(c *gin.Context) {
+	h.mediator.Send(c, api.Query, decode_vin.Name, new(decode_vin.DecodeVinRequest))
+}
+
+func (h *VinController) CheckVinWatchlist(c *gin.Context) {
+	h.mediator.Send(c, api.Query, check_vin_watchlist.Name, new(check_vin_watchlist.CheckVinWatchlistRequest))
+}
@@ -0,0 +1,43 @@
+// cmd/api/controllers/warranty_controller.go
+
+package controllers
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/commands/delete_warranty_template"
+	"car-service/internal/application/commands/new_warranty_template"
+	"car-service/internal/application/queries/get_car_warranty"
+	"car-service/internal/application/queries/get_warranty_template"
+	"car-service/internal/application/queries/get_warranty_templates"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WarrantyController struct {
+	mediator *ginadapter.Adapter
+}
+
+func NewWarrantyController(mediator *ginadapter.Adapter) *WarrantyController {
+	return &WarrantyController{mediator: mediator}
+}
+
+func (h *WarrantyController) CreateWarrantyTemplate(c *gin.Context) {
+	h.mediator.Send(c, api.Command, new_warranty_template.Name, new(new_warranty_template.NewWarrantyTemplateRequest))
+}
+
+func (h *WarrantyController) GetWarrantyTemplates(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_warranty_templates.Name, new(get_warranty_templates.GetWarrantyTemplatesRequest))
+}
+
+func (h *WarrantyController) GetWarrantyTemplate(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_warranty_template.Name, new(get_warranty_template.GetWarrantyTemplateRequest))
+}
+
+func (h *WarrantyController) DeleteWarrantyTemplate(c *gin.Context) {
+	h.mediator.Send(c, api.Command, delete_warranty_template.Name, new(delete_warranty_template.DeleteWarrantyTemplateRequest))
+}
+
+func (h *WarrantyController) GetCarWarranty(c *gin.Context) {
+	h.mediator.Send(c, api.Query, get_car_warranty.Name, new(get_car_warranty.GetCarWarrantyRequest))
+}
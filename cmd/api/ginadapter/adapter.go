@@ -0,0 +1,141 @@
+// cmd/api/ginadapter/adapter.go
+
+package ginadapter
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/cmd/api/response"
+	"car-service/internal/domain/errors"
+	"car-service/internal/domain/pagination"
+	stderrors "errors"
+	"io"
+	"log"
+	"net/http"
+
+	"bytes"
+	"encoding/json"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// NotFoundErrorCode identifica en las respuestas a los recursos inexistentes
+const NotFoundErrorCode = "NOT_FOUND"
+
+// DuplicateKeyErrorCode identifica en las respuestas a las violaciones de unicidad detectadas por la base de datos
+const DuplicateKeyErrorCode = "DUPLICATE_KEY"
+
+// HandlerNotFoundErrorCode identifica en las respuestas a las rutas sin command ni query registrados en el mediator
+const HandlerNotFoundErrorCode = "HANDLER_NOT_FOUND"
+
+// pagedResult es implementado por los resultados paginados, como pagination.Page
+type pagedResult interface {
+	Content() any
+	Metadata() pagination.Metadata
+}
+
+// Adapter expone el mediator sobre gin, traduciendo resultados y errores a StandardResponse
+type Adapter struct {
+	mediator *api.Mediator
+}
+
+func NewAdapter(mediator *api.Mediator) *Adapter {
+	return &Adapter{mediator: mediator}
+}
+
+func (a *Adapter) LogRequest(c *gin.Context, requestType any) {
+	log.Printf("Procesando solicitud: Content-Type=%s, Content-Length=%d, Path=%s", c.GetHeader("Content-Type"), c.Request.ContentLength, c.Request.URL.Path)
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		log.Printf("Error al leer el cuerpo de la solicitud: %v", err)
+	} else {
+		c.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+		log.Printf("Cuerpo de la solicitud: %s", string(body))
+		if err := json.Unmarshal(body, requestType); err != nil {
+			log.Printf("Error al deserializar JSON: %v", err)
+			return
+		}
+	}
+}
+
+// Send despacha la solicitud en el mediator y escribe la respuesta HTTP
+func (a *Adapter) Send(c *gin.Context, actionType string, name string, requestType any) {
+	cmdCtx := api.NewCommandContext(c.Request.Context())
+
+	a.LogRequest(c, requestType)
+	if err := bindPathParams(c, requestType); err != nil {
+		a.writeError(c, actionType, err, cmdCtx.Decisions())
+		return
+	}
+	if actionType == api.Query {
+		if err := bindQueryParams(c, requestType); err != nil {
+			a.writeError(c, actionType, err, cmdCtx.Decisions())
+			return
+		}
+	}
+
+	result, err := a.mediator.Dispatch(cmdCtx, name, requestType)
+	if err != nil {
+		a.writeError(c, actionType, err, cmdCtx.Decisions())
+		return
+	}
+
+	status := http.StatusOK
+	if actionType == api.Command && c.Request.Method == http.MethodPost {
+		status = http.StatusCreated
+	}
+	if paged, ok := result.(pagedResult); ok {
+		response.PagedJSON(c, status, "Operación completada con éxito", paged.Content(), paged.Metadata(), cmdCtx.Decisions())
+		return
+	}
+	response.JSON(c, status, "Operación completada con éxito", result, nil, cmdCtx.Decisions())
+}
+
+func (a *Adapter) writeError(c *gin.Context, actionType string, err error, decisions []string) {
+	var validationErrors api.ValidationErrors
+	if stderrors.As(err, &validationErrors) {
+		response.JSON(c, http.StatusBadRequest,
+			"Bad Request", nil, validationErrors.Messages(), decisions)
+		return
+	}
+
+	var businessErr *errors.BusinessError
+	if stderrors.As(err, &businessErr) {
+		response.ErrorJSON(c, http.StatusConflict, businessErr.Code,
+			"Error de negocio", []string{businessErr.Message}, decisions)
+		return
+	}
+
+	if stderrors.Is(err, gorm.ErrRecordNotFound) {
+		response.ErrorJSON(c, http.StatusNotFound, NotFoundErrorCode,
+			"El recurso solicitado no existe", []string{err.Error()}, decisions)
+		return
+	}
+
+	// Una solicitud concurrente puede superar la validación previa y chocar con el índice único
+	if stderrors.Is(err, gorm.ErrDuplicatedKey) {
+		response.ErrorJSON(c, http.StatusConflict, DuplicateKeyErrorCode,
+			"El recurso ya existe", []string{"Ya existe un registro con los mismos datos únicos"}, decisions)
+		return
+	}
+
+	// La ruta existe pero su handler no fue registrado: la operación no está implementada
+	if stderrors.Is(err, api.ErrHandlerNotFound) {
+		response.ErrorJSON(c, http.StatusNotImplemented, HandlerNotFoundErrorCode,
+			"Operación no implementada", []string{err.Error()}, decisions)
+		return
+	}
+
+	var panicErr *api.PanicError
+	if stderrors.As(err, &panicErr) {
+		response.ErrorJSON(c, http.StatusInternalServerError, api.PanicErrorCode,
+			"Error interno del servidor", []string{"Se produjo un error inesperado al procesar la solicitud"}, decisions)
+		return
+	}
+
+	message := "Error al ejecutar el comando"
+	if actionType == api.Query {
+		message = "Error al ejecutar query"
+	}
+	response.JSON(c, http.StatusInternalServerError, message, nil, []string{err.Error()}, decisions)
+}
@@ -0,0 +1,102 @@
+package ginadapter_test
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/cmd/api/response"
+	"car-service/internal/domain/errors"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type lookupRequest struct {
+	Key string `uri:"key"`
+}
+
+// lookupQuery retorna el error asociado a la clave solicitada
+type lookupQuery struct {
+	errs map[string]error
+}
+
+func (q *lookupQuery) Execute(request api.QueryRequest[lookupRequest], ctx context.Context) (string, error) {
+	if err := q.errs[request.Data.Key]; err != nil {
+		return "", err
+	}
+	return request.Data.Key, nil
+}
+
+type noopUnitOfWork struct{}
+
+func (noopUnitOfWork) Begin(ctx context.Context) (context.Context, error) { return ctx, nil }
+func (noopUnitOfWork) Commit(ctx context.Context) error                   { return nil }
+func (noopUnitOfWork) Rollback(ctx context.Context) error                 { return nil }
+
+func newTestRouter(mediator *api.Mediator) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	adapter := ginadapter.NewAdapter(mediator)
+	router := gin.New()
+	router.GET("/lookup/:key", func(c *gin.Context) {
+		adapter.Send(c, api.Query, "Lookup", &lookupRequest{})
+	})
+	router.GET("/missing", func(c *gin.Context) {
+		adapter.Send(c, api.Query, "Missing", &lookupRequest{})
+	})
+	return router
+}
+
+func silenceLog(t *testing.T) {
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() {
+		log.SetOutput(os.Stderr)
+	})
+}
+
+func TestSendMapsErrorsToStatus(t *testing.T) {
+	silenceLog(t)
+	mediator := api.NewMediator(noopUnitOfWork{})
+	api.RegisterQuery[lookupRequest, string](mediator, "Lookup", &lookupQuery{errs: map[string]error{
+		"missing-record": gorm.ErrRecordNotFound,
+		"duplicated-key": gorm.ErrDuplicatedKey,
+		"duplicate":      errors.NewBusinessError("DUPLICATE_VIN", "Ya existe un vehículo con este número de VIN"),
+	}})
+	router := newTestRouter(mediator)
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+		code   string
+	}{
+		{name: "ok", path: "/lookup/ok", status: http.StatusOK},
+		{name: "registro inexistente", path: "/lookup/missing-record", status: http.StatusNotFound, code: ginadapter.NotFoundErrorCode},
+		{name: "error de negocio", path: "/lookup/duplicate", status: http.StatusConflict, code: "DUPLICATE_VIN"},
+		{name: "violación de unicidad", path: "/lookup/duplicated-key", status: http.StatusConflict, code: ginadapter.DuplicateKeyErrorCode},
+		{name: "handler no registrado", path: "/missing", status: http.StatusNotImplemented, code: ginadapter.HandlerNotFoundErrorCode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			if recorder.Code != tt.status {
+				t.Errorf("status = %d, se esperaba %d", recorder.Code, tt.status)
+			}
+			var body response.StandardResponse
+			if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+				t.Fatalf("respuesta inválida: %v", err)
+			}
+			if body.Code != tt.code {
+				t.Errorf("code = %q, se esperaba %q", body.Code, tt.code)
+			}
+		})
+	}
+}
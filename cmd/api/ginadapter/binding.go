@@ -0,0 +1,116 @@
+package ginadapter
+
+import (
+	api "car-service/cmd/api/mediator"
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bindPathParams copia los parámetros de ruta en los campos de la solicitud marcados con el tag `uri`.
+// Soporta strings, números, booleanos y tipos que implementan encoding.TextUnmarshaler (como uuid.UUID).
+func bindPathParams(c *gin.Context, request any) error {
+	return bindValues(request, "uri", func(key string) (string, bool) {
+		value := c.Param(key)
+		return value, value != ""
+	})
+}
+
+// bindQueryParams copia los parámetros del query string en los campos de la solicitud marcados con el tag `form`
+func bindQueryParams(c *gin.Context, request any) error {
+	return bindValues(request, "form", func(key string) (string, bool) {
+		value, ok := c.GetQuery(key)
+		return value, ok && value != ""
+	})
+}
+
+func bindValues(request any, tag string, lookup func(key string) (string, bool)) error {
+	target := reflect.ValueOf(request)
+	if target.Kind() != reflect.Ptr || target.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	if validationErrors := bindStruct(target.Elem(), tag, lookup); len(validationErrors) > 0 {
+		return validationErrors
+	}
+	return nil
+}
+
+// bindStruct completa los campos del struct; los structs embebidos comparten los parámetros de
+// la solicitud que los contiene
+func bindStruct(target reflect.Value, tag string, lookup func(key string) (string, bool)) api.ValidationErrors {
+	var validationErrors api.ValidationErrors
+	for i := 0; i < target.NumField(); i++ {
+		field := target.Type().Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && field.IsExported() {
+			validationErrors = append(validationErrors, bindStruct(target.Field(i), tag, lookup)...)
+			continue
+		}
+
+		key := field.Tag.Get(tag)
+		if key == "" || key == "-" || !field.IsExported() {
+			continue
+		}
+		raw, ok := lookup(key)
+		if !ok {
+			continue
+		}
+		if err := setValue(target.Field(i), raw); err != nil {
+			validationErrors = append(validationErrors, &api.ValidationError{
+				Field:   key,
+				Message: fmt.Sprintf("Valor inválido: %s", raw),
+			})
+		}
+	}
+	return validationErrors
+}
+
+func setValue(field reflect.Value, raw string) error {
+	if field.Kind() == reflect.Ptr {
+		value := reflect.New(field.Type().Elem())
+		if err := setValue(value.Elem(), raw); err != nil {
+			return err
+		}
+		field.Set(value)
+		return nil
+	}
+
+	if unmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(raw))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.ParseInt(raw, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(value)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseUint(raw, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(value)
+	case reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(raw, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(value)
+	case reflect.Bool:
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		field.SetBool(value)
+	default:
+		return fmt.Errorf("tipo no soportado: %s", field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package ginadapter_test
+
+import (
+	"car-service/cmd/api/ginadapter"
+	api "car-service/cmd/api/mediator"
+	"car-service/cmd/api/response"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// countingUnitOfWork cuenta las transacciones iniciadas, confirmadas y revertidas
+type countingUnitOfWork struct {
+	mu                           sync.Mutex
+	begun, committed, rolledBack int
+}
+
+func (u *countingUnitOfWork) Begin(ctx context.Context) (context.Context, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.begun++
+	return ctx, nil
+}
+
+func (u *countingUnitOfWork) Commit(ctx context.Context) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.committed++
+	return nil
+}
+
+func (u *countingUnitOfWork) Rollback(ctx context.Context) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.rolledBack++
+	return nil
+}
+
+func (u *countingUnitOfWork) Counts() (begun, committed, rolledBack int) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.begun, u.committed, u.rolledBack
+}
+
+// panickingCommand entra en pánico cuando la clave solicitada es "panic"
+type panickingCommand struct{}
+
+func (c *panickingCommand) Validate(request api.CommandRequest[lookupRequest], ctx *api.CommandContext) []*api.ValidationError {
+	return nil
+}
+
+func (c *panickingCommand) Execute(request api.CommandRequest[lookupRequest], ctx *context.Context) (string, error) {
+	if request.Data.Key == "panic" {
+		var values map[string]int
+		values[request.Data.Key]++
+	}
+	return request.Data.Key, nil
+}
+
+// panickingQuery entra en pánico cuando la clave solicitada es "panic"
+type panickingQuery struct{}
+
+func (q *panickingQuery) Execute(request api.QueryRequest[lookupRequest], ctx context.Context) (string, error) {
+	if request.Data.Key == "panic" {
+		var values []string
+		return values[1], nil
+	}
+	return request.Data.Key, nil
+}
+
+func newPanicRouter(uow *countingUnitOfWork) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	mediator := api.NewMediator(uow)
+	api.RegisterCommand[lookupRequest, string](mediator, "Explode", &panickingCommand{})
+	api.RegisterQuery[lookupRequest, string](mediator, "Peek", &panickingQuery{})
+
+	adapter := ginadapter.NewAdapter(mediator)
+	// Sin gin.Recovery: si el panic escapara del mediator, el test se interrumpiría
+	router := gin.New()
+	router.POST("/explode/:key", func(c *gin.Context) {
+		adapter.Send(c, api.Command, "Explode", &lookupRequest{})
+	})
+	router.GET("/peek/:key", func(c *gin.Context) {
+		adapter.Send(c, api.Query, "Peek", &lookupRequest{})
+	})
+	return router
+}
+
+func serve(t *testing.T, router *gin.Engine, method, path string) (int, response.StandardResponse) {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(method, path, nil))
+
+	var body response.StandardResponse
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatalf("respuesta inválida: %v", err)
+	}
+	return recorder.Code, body
+}
+
+func TestCommandPanicReturnsInternalPanicAndRollsBack(t *testing.T) {
+	silenceLog(t)
+	uow := &countingUnitOfWork{}
+	router := newPanicRouter(uow)
+
+	status, body := serve(t, router, http.MethodPost, "/explode/panic")
+	if status != http.StatusInternalServerError {
+		t.Errorf("status = %d, se esperaba %d", status, http.StatusInternalServerError)
+	}
+	if body.Code != api.PanicErrorCode {
+		t.Errorf("code = %q, se esperaba %q", body.Code, api.PanicErrorCode)
+	}
+	if begun, committed, rolledBack := uow.Counts(); begun != 1 || committed != 0 || rolledBack != 1 {
+		t.Errorf("transacciones: iniciadas=%d confirmadas=%d revertidas=%d; se esperaba 1/0/1", begun, committed, rolledBack)
+	}
+
+	// El proceso sigue atendiendo solicitudes
+	status, body = serve(t, router, http.MethodPost, "/explode/ok")
+	if status != http.StatusCreated {
+		t.Errorf("status luego del panic = %d, se esperaba %d", status, http.StatusCreated)
+	}
+	if body.Data != "ok" {
+		t.Errorf("data = %v, se esperaba ok", body.Data)
+	}
+	if begun, committed, rolledBack := uow.Counts(); begun != 2 || committed != 1 || rolledBack != 1 {
+		t.Errorf("transacciones: iniciadas=%d confirmadas=%d revertidas=%d; se esperaba 2/1/1", begun, committed, rolledBack)
+	}
+}
+
+func TestQueryPanicReturnsInternalPanic(t *testing.T) {
+	silenceLog(t)
+	uow := &countingUnitOfWork{}
+	router := newPanicRouter(uow)
+
+	status, body := serve(t, router, http.MethodGet, "/peek/panic")
+	if status != http.StatusInternalServerError {
+		t.Errorf("status = %d, se esperaba %d", status, http.StatusInternalServerError)
+	}
+	if body.Code != api.PanicErrorCode {
+		t.Errorf("code = %q, se esperaba %q", body.Code, api.PanicErrorCode)
+	}
+
+	status, body = serve(t, router, http.MethodGet, "/peek/ok")
+	if status != http.StatusOK {
+		t.Errorf("status luego del panic = %d, se esperaba %d", status, http.StatusOK)
+	}
+	if body.Data != "ok" {
+		t.Errorf("data = %v, se esperaba ok", body.Data)
+	}
+	if begun, _, _ := uow.Counts(); begun != 0 {
+		t.Errorf("las queries no deberían abrir transacciones, se abrieron %d", begun)
+	}
+}
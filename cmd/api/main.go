@@ -2,12 +2,83 @@ package main
 
 import (
 	"car-service/cmd/api/controllers"
+	"car-service/cmd/api/ginadapter"
 	api "car-service/cmd/api/mediator"
 	"car-service/cmd/api/server"
+	"car-service/internal/application/commands/cancel_registration"
+	"car-service/internal/application/commands/change_car_status"
+	"car-service/internal/application/commands/complete_recall_remedy"
+	"car-service/internal/application/commands/deactivate_brand"
+	"car-service/internal/application/commands/delete_brand"
+	"car-service/internal/application/commands/delete_car"
+	"car-service/internal/application/commands/delete_model"
+	"car-service/internal/application/commands/delete_model_trim"
+	"car-service/internal/application/commands/delete_owner"
+	"car-service/internal/application/commands/delete_service_record"
+	"car-service/internal/application/commands/delete_warranty_template"
+	"car-service/internal/application/commands/new_brand"
 	"car-service/internal/application/commands/new_car"
+	"car-service/internal/application/commands/new_inspection"
+	"car-service/internal/application/commands/new_insurance_policy"
+	"car-service/internal/application/commands/new_model"
+	"car-service/internal/application/commands/new_model_trim"
+	"car-service/internal/application/commands/new_odometer_reading"
+	"car-service/internal/application/commands/new_owner"
+	"car-service/internal/application/commands/new_recall"
+	"car-service/internal/application/commands/new_registration"
+	"car-service/internal/application/commands/new_service_record"
+	"car-service/internal/application/commands/new_stolen_report"
+	"car-service/internal/application/commands/new_warranty_template"
+	"car-service/internal/application/commands/patch_car"
+	"car-service/internal/application/commands/recover_stolen_report"
+	"car-service/internal/application/commands/terminate_insurance_policy"
+	"car-service/internal/application/commands/transfer_car_ownership"
+	"car-service/internal/application/commands/update_brand"
+	"car-service/internal/application/commands/update_car"
+	"car-service/internal/application/commands/update_model"
+	"car-service/internal/application/commands/update_model_trim"
+	"car-service/internal/application/commands/update_owner"
+	"car-service/internal/application/commands/update_service_record"
+	"car-service/internal/application/dto"
+	"car-service/internal/application/queries/check_vin_watchlist"
+	"car-service/internal/application/queries/decode_vin"
+	"car-service/internal/application/queries/get_brand"
+	"car-service/internal/application/queries/get_brand_models"
+	"car-service/internal/application/queries/get_brands"
+	"car-service/internal/application/queries/get_car"
+	"car-service/internal/application/queries/get_car_inspections"
+	"car-service/internal/application/queries/get_car_insurance_policies"
+	"car-service/internal/application/queries/get_car_recalls"
+	"car-service/internal/application/queries/get_car_registrations"
+	"car-service/internal/application/queries/get_car_status_history"
+	"car-service/internal/application/queries/get_car_stolen_reports"
+	"car-service/internal/application/queries/get_car_warranty"
 	"car-service/internal/application/queries/get_cars"
+	"car-service/internal/application/queries/get_due_inspections"
+	"car-service/internal/application/queries/get_inspection"
+	"car-service/internal/application/queries/get_insurance_alerts"
+	"car-service/internal/application/queries/get_model"
+	"car-service/internal/application/queries/get_model_trim"
+	"car-service/internal/application/queries/get_model_trims"
+	"car-service/internal/application/queries/get_models"
+	"car-service/internal/application/queries/get_odometer_history"
+	"car-service/internal/application/queries/get_owner"
+	"car-service/internal/application/queries/get_owner_cars"
+	"car-service/internal/application/queries/get_owner_insurance_policies"
+	"car-service/internal/application/queries/get_owners"
+	"car-service/internal/application/queries/get_ownership_history"
+	"car-service/internal/application/queries/get_recall"
+	"car-service/internal/application/queries/get_recall_affected_cars"
+	"car-service/internal/application/queries/get_service_record"
+	"car-service/internal/application/queries/get_service_records"
+	"car-service/internal/application/queries/get_stolen_report"
+	"car-service/internal/application/queries/get_warranty_template"
+	"car-service/internal/application/queries/get_warranty_templates"
 	"car-service/internal/application/services"
+	"car-service/internal/domain/pagination"
 	"car-service/internal/domain/repositories"
+	domainservices "car-service/internal/domain/services"
+	"car-service/internal/domain/vin"
 	gormrepo "car-service/internal/infrastructure/gorm"
 	"car-service/internal/infrastructure/migrations"
 	"car-service/pkg/config"
@@ -36,6 +107,9 @@ func run() error {
 	if err != nil {
 		return err
 	}
+	if err := vin.SetCheckDigitScope(vin.CheckDigitScope(env.VINCheckDigitScope)); err != nil {
+		return err
+	}
 
 	// Configuración de la base de datos
 	db, err := setupDatabase(env)
@@ -47,17 +121,85 @@ func run() error {
 	var carRepo repositories.CarRepository = gormrepo.NewCarRepository(db)
 	var modelRepo repositories.ModelRepository = gormrepo.NewModelRepository(db)
 	var ownerRepo repositories.OwnerRepository = gormrepo.NewOwnerRepository(db)
+	var brandRepo repositories.BrandRepository = gormrepo.NewBrandRepository(db)
+	var wmiRepo repositories.ManufacturerIdentifierRepository = gormrepo.NewManufacturerIdentifierRepository(db)
+	var ownershipRepo repositories.OwnershipRecordRepository = gormrepo.NewOwnershipRecordRepository(db)
+	var serviceRecordRepo repositories.ServiceRecordRepository = gormrepo.NewServiceRecordRepository(db)
+	var odometerRepo repositories.OdometerReadingRepository = gormrepo.NewOdometerReadingRepository(db)
+	var statusRepo repositories.CarStatusTransitionRepository = gormrepo.NewCarStatusTransitionRepository(db)
+	var stolenRepo repositories.StolenReportRepository = gormrepo.NewStolenReportRepository(db)
+	var registrationRepo repositories.RegistrationRepository = gormrepo.NewRegistrationRepository(db)
+	var inspectionRepo repositories.InspectionRepository = gormrepo.NewInspectionRepository(db)
+	var policyRepo repositories.InsurancePolicyRepository = gormrepo.NewInsurancePolicyRepository(db)
+	var recallRepo repositories.RecallRepository = gormrepo.NewRecallRepository(db)
+	var carRecallRepo repositories.CarRecallRepository = gormrepo.NewCarRecallRepository(db)
+	var warrantyRepo repositories.WarrantyTemplateRepository = gormrepo.NewWarrantyTemplateRepository(db)
+	var trimRepo repositories.ModelTrimRepository = gormrepo.NewModelTrimRepository(db)
+
+	// Inicializar servicios
+	carService := services.NewCarService(carRepo, modelRepo, ownerRepo, wmiRepo, ownershipRepo, statusRepo, stolenRepo, recallRepo, carRecallRepo, trimRepo)
+	ownerService := services.NewOwnerService(ownerRepo, carRepo)
+	brandService := services.NewBrandService(brandRepo, modelRepo)
+	modelService := services.NewModelService(modelRepo, brandRepo, carRepo, trimRepo)
+	ownershipService := services.NewOwnershipService(ownershipRepo, carRepo, ownerRepo, statusRepo, stolenRepo, policyRepo)
+	odometerService := services.NewOdometerService(odometerRepo, carRepo)
+	stolenReportService := services.NewStolenReportService(stolenRepo, carRepo, statusRepo)
+	registrationService := services.NewRegistrationService(registrationRepo, carRepo)
+	warrantyService := services.NewWarrantyService(warrantyRepo, carRepo, modelRepo, brandRepo, registrationRepo, odometerService)
+	serviceRecordService := services.NewServiceRecordService(serviceRecordRepo, carRepo, odometerService, warrantyService)
+	inspectionService := services.NewInspectionService(inspectionRepo, carRepo, odometerService)
+	insurancePolicyService := services.NewInsurancePolicyService(policyRepo, carRepo, ownerRepo)
+	recallService := services.NewRecallService(recallRepo, carRecallRepo, carRepo, modelRepo)
+
+	// Registrar commands y queries
+	unitOfWork := gormrepo.NewUnitOfWork(db)
+	mediator := api.NewMediator(unitOfWork)
+	registerCarHandlers(mediator, carService)
+	registerOwnerHandlers(mediator, ownerService)
+	registerBrandHandlers(mediator, brandService)
+	registerModelHandlers(mediator, modelService)
+	registerOwnershipHandlers(mediator, ownershipService)
+	registerServiceRecordHandlers(mediator, serviceRecordService)
+	registerOdometerHandlers(mediator, odometerService)
+	registerStolenReportHandlers(mediator, stolenReportService)
+	registerRegistrationHandlers(mediator, registrationService)
+	registerInspectionHandlers(mediator, inspectionService)
+	registerInsurancePolicyHandlers(mediator, insurancePolicyService)
+	registerRecallHandlers(mediator, recallService)
+	registerWarrantyHandlers(mediator, warrantyService)
+	api.RegisterQuery[decode_vin.DecodeVinRequest, *vin.Decoded](mediator, decode_vin.Name, decode_vin.NewDecodeVinQuery())
 
-	carService := services.NewCarService(carRepo, modelRepo, ownerRepo)
-	mediator := api.NewMediator(db)
-	mediator.RegisterCommand(new_car.Name, new_car.CreateNewCarCommand(carService))
-	mediator.RegisterQuery(get_cars.Name, get_cars.NewGetCarsQuery(carService))
-	carController := controllers.NewCarController(mediator)
+	adapter := ginadapter.NewAdapter(mediator)
+	carController := controllers.NewCarController(adapter)
+	ownerController := controllers.NewOwnerController(adapter)
+	brandController := controllers.NewBrandController(adapter)
+	modelController := controllers.NewModelController(adapter)
+	vinController := controllers.NewVinController(adapter)
+	serviceRecordController := controllers.NewServiceRecordController(adapter)
+	odometerController := controllers.NewOdometerController(adapter)
+	stolenReportController := controllers.NewStolenReportController(adapter)
+	registrationController := controllers.NewRegistrationController(adapter)
+	inspectionController := controllers.NewInspectionController(adapter)
+	insurancePolicyController := controllers.NewInsurancePolicyController(adapter)
+	recallController := controllers.NewRecallController(adapter)
+	warrantyController := controllers.NewWarrantyController(adapter)
 
 	// Configurar el servidor
 	serverCfg := &server.ServerConfig{
-		CarController: carController,
-		Port:          env.ServerPort,
+		CarController:             carController,
+		OwnerController:           ownerController,
+		BrandController:           brandController,
+		ModelController:           modelController,
+		VinController:             vinController,
+		ServiceRecordController:   serviceRecordController,
+		OdometerController:        odometerController,
+		StolenReportController:    stolenReportController,
+		RegistrationController:    registrationController,
+		InspectionController:      inspectionController,
+		InsurancePolicyController: insurancePolicyController,
+		RecallController:          recallController,
+		WarrantyController:        warrantyController,
+		Port:                      env.ServerPort,
 	}
 
 	// Crear y configurar el servidor
@@ -67,9 +209,116 @@ func run() error {
 	return srv.Start()
 }
 
+func registerCarHandlers(mediator *api.Mediator, carService domainservices.CarService) {
+	api.RegisterCommand[new_car.NewCarRequest, *new_car.NewCarResponse](mediator, new_car.Name, new_car.CreateNewCarCommand(carService))
+	api.RegisterCommand[update_car.UpdateCarRequest, *update_car.UpdateCarResponse](mediator, update_car.Name, update_car.CreateUpdateCarCommand(carService))
+	api.RegisterCommand[patch_car.PatchCarRequest, *patch_car.PatchCarResponse](mediator, patch_car.Name, patch_car.CreatePatchCarCommand(carService))
+	api.RegisterCommand[delete_car.DeleteCarRequest, *delete_car.DeleteCarResponse](mediator, delete_car.Name, delete_car.CreateDeleteCarCommand(carService))
+	api.RegisterQuery[get_cars.GetCarsRequest, *pagination.Page[*dto.CarResponse]](mediator, get_cars.Name, get_cars.NewGetCarsQuery(carService))
+	api.RegisterQuery[get_car.GetCarRequest, *dto.CarResponse](mediator, get_car.Name, get_car.NewGetCarQuery(carService))
+	api.RegisterCommand[change_car_status.ChangeCarStatusRequest, *change_car_status.ChangeCarStatusResponse](mediator, change_car_status.Name, change_car_status.CreateChangeCarStatusCommand(carService))
+	api.RegisterQuery[get_car_status_history.GetCarStatusHistoryRequest, []*dto.CarStatusTransitionResponse](mediator, get_car_status_history.Name, get_car_status_history.NewGetCarStatusHistoryQuery(carService))
+}
+
+func registerOwnerHandlers(mediator *api.Mediator, ownerService domainservices.OwnerService) {
+	api.RegisterCommand[new_owner.NewOwnerRequest, *new_owner.NewOwnerResponse](mediator, new_owner.Name, new_owner.CreateNewOwnerCommand(ownerService))
+	api.RegisterCommand[update_owner.UpdateOwnerRequest, *update_owner.UpdateOwnerResponse](mediator, update_owner.Name, update_owner.CreateUpdateOwnerCommand(ownerService))
+	api.RegisterCommand[delete_owner.DeleteOwnerRequest, *delete_owner.DeleteOwnerResponse](mediator, delete_owner.Name, delete_owner.CreateDeleteOwnerCommand(ownerService))
+	api.RegisterQuery[get_owners.GetOwnersRequest, []*dto.OwnerResponse](mediator, get_owners.Name, get_owners.NewGetOwnersQuery(ownerService))
+	api.RegisterQuery[get_owner.GetOwnerRequest, *dto.OwnerResponse](mediator, get_owner.Name, get_owner.NewGetOwnerQuery(ownerService))
+	api.RegisterQuery[get_owner_cars.GetOwnerCarsRequest, []*dto.CarResponse](mediator, get_owner_cars.Name, get_owner_cars.NewGetOwnerCarsQuery(ownerService))
+}
+
+func registerBrandHandlers(mediator *api.Mediator, brandService domainservices.BrandService) {
+	api.RegisterCommand[new_brand.NewBrandRequest, *new_brand.NewBrandResponse](mediator, new_brand.Name, new_brand.CreateNewBrandCommand(brandService))
+	api.RegisterCommand[update_brand.UpdateBrandRequest, *update_brand.UpdateBrandResponse](mediator, update_brand.Name, update_brand.CreateUpdateBrandCommand(brandService))
+	api.RegisterCommand[deactivate_brand.DeactivateBrandRequest, *deactivate_brand.DeactivateBrandResponse](mediator, deactivate_brand.Name, deactivate_brand.CreateDeactivateBrandCommand(brandService))
+	api.RegisterCommand[delete_brand.DeleteBrandRequest, *delete_brand.DeleteBrandResponse](mediator, delete_brand.Name, delete_brand.CreateDeleteBrandCommand(brandService))
+	api.RegisterQuery[get_brands.GetBrandsRequest, []*dto.BrandResponse](mediator, get_brands.Name, get_brands.NewGetBrandsQuery(brandService))
+	api.RegisterQuery[get_brand.GetBrandRequest, *dto.BrandResponse](mediator, get_brand.Name, get_brand.NewGetBrandQuery(brandService))
+}
+
+func registerModelHandlers(mediator *api.Mediator, modelService domainservices.ModelService) {
+	api.RegisterCommand[new_model.NewModelRequest, *new_model.NewModelResponse](mediator, new_model.Name, new_model.CreateNewModelCommand(modelService))
+	api.RegisterCommand[update_model.UpdateModelRequest, *update_model.UpdateModelResponse](mediator, update_model.Name, update_model.CreateUpdateModelCommand(modelService))
+	api.RegisterCommand[delete_model.DeleteModelRequest, *delete_model.DeleteModelResponse](mediator, delete_model.Name, delete_model.CreateDeleteModelCommand(modelService))
+	api.RegisterQuery[get_models.GetModelsRequest, []*dto.ModelResponse](mediator, get_models.Name, get_models.NewGetModelsQuery(modelService))
+	api.RegisterQuery[get_model.GetModelRequest, *dto.ModelResponse](mediator, get_model.Name, get_model.NewGetModelQuery(modelService))
+	api.RegisterQuery[get_brand_models.GetBrandModelsRequest, []*dto.ModelResponse](mediator, get_brand_models.Name, get_brand_models.NewGetBrandModelsQuery(modelService))
+	api.RegisterCommand[new_model_trim.NewModelTrimRequest, *new_model_trim.NewModelTrimResponse](mediator, new_model_trim.Name, new_model_trim.CreateNewModelTrimCommand(modelService))
+	api.RegisterCommand[update_model_trim.UpdateModelTrimRequest, *update_model_trim.UpdateModelTrimResponse](mediator, update_model_trim.Name, update_model_trim.CreateUpdateModelTrimCommand(modelService))
+	api.RegisterCommand[delete_model_trim.DeleteModelTrimRequest, *delete_model_trim.DeleteModelTrimResponse](mediator, delete_model_trim.Name, delete_model_trim.CreateDeleteModelTrimCommand(modelService))
+	api.RegisterQuery[get_model_trims.GetModelTrimsRequest, []*dto.ModelTrimResponse](mediator, get_model_trims.Name, get_model_trims.NewGetModelTrimsQuery(modelService))
+	api.RegisterQuery[get_model_trim.GetModelTrimRequest, *dto.ModelTrimResponse](mediator, get_model_trim.Name, get_model_trim.NewGetModelTrimQuery(modelService))
+}
+
+func registerOwnershipHandlers(mediator *api.Mediator, ownershipService domainservices.OwnershipService) {
+	api.RegisterCommand[transfer_car_ownership.TransferCarOwnershipRequest, *transfer_car_ownership.TransferCarOwnershipResponse](mediator, transfer_car_ownership.Name, transfer_car_ownership.CreateTransferCarOwnershipCommand(ownershipService))
+	api.RegisterQuery[get_ownership_history.GetOwnershipHistoryRequest, []*dto.OwnershipRecordResponse](mediator, get_ownership_history.Name, get_ownership_history.NewGetOwnershipHistoryQuery(ownershipService))
+}
+
+func registerServiceRecordHandlers(mediator *api.Mediator, serviceRecordService domainservices.ServiceRecordService) {
+	api.RegisterCommand[new_service_record.NewServiceRecordRequest, *new_service_record.NewServiceRecordResponse](mediator, new_service_record.Name, new_service_record.CreateNewServiceRecordCommand(serviceRecordService))
+	api.RegisterCommand[update_service_record.UpdateServiceRecordRequest, *update_service_record.UpdateServiceRecordResponse](mediator, update_service_record.Name, update_service_record.CreateUpdateServiceRecordCommand(serviceRecordService))
+	api.RegisterCommand[delete_service_record.DeleteServiceRecordRequest, *delete_service_record.DeleteServiceRecordResponse](mediator, delete_service_record.Name, delete_service_record.CreateDeleteServiceRecordCommand(serviceRecordService))
+	api.RegisterQuery[get_service_records.GetServiceRecordsRequest, []*dto.ServiceRecordResponse](mediator, get_service_records.Name, get_service_records.NewGetServiceRecordsQuery(serviceRecordService))
+	api.RegisterQuery[get_service_record.GetServiceRecordRequest, *dto.ServiceRecordResponse](mediator, get_service_record.Name, get_service_record.NewGetServiceRecordQuery(serviceRecordService))
+}
+
+func registerOdometerHandlers(mediator *api.Mediator, odometerService domainservices.OdometerService) {
+	api.RegisterCommand[new_odometer_reading.NewOdometerReadingRequest, *new_odometer_reading.NewOdometerReadingResponse](mediator, new_odometer_reading.Name, new_odometer_reading.CreateNewOdometerReadingCommand(odometerService))
+	api.RegisterQuery[get_odometer_history.GetOdometerHistoryRequest, *dto.OdometerHistoryResponse](mediator, get_odometer_history.Name, get_odometer_history.NewGetOdometerHistoryQuery(odometerService))
+}
+
+func registerStolenReportHandlers(mediator *api.Mediator, stolenReportService domainservices.StolenReportService) {
+	api.RegisterCommand[new_stolen_report.NewStolenReportRequest, *new_stolen_report.NewStolenReportResponse](mediator, new_stolen_report.Name, new_stolen_report.CreateNewStolenReportCommand(stolenReportService))
+	api.RegisterCommand[recover_stolen_report.RecoverStolenReportRequest, *recover_stolen_report.RecoverStolenReportResponse](mediator, recover_stolen_report.Name, recover_stolen_report.CreateRecoverStolenReportCommand(stolenReportService))
+	api.RegisterQuery[get_stolen_report.GetStolenReportRequest, *dto.StolenReportResponse](mediator, get_stolen_report.Name, get_stolen_report.NewGetStolenReportQuery(stolenReportService))
+	api.RegisterQuery[get_car_stolen_reports.GetCarStolenReportsRequest, []*dto.StolenReportResponse](mediator, get_car_stolen_reports.Name, get_car_stolen_reports.NewGetCarStolenReportsQuery(stolenReportService))
+	api.RegisterQuery[check_vin_watchlist.CheckVinWatchlistRequest, *dto.VinWatchlistResponse](mediator, check_vin_watchlist.Name, check_vin_watchlist.NewCheckVinWatchlistQuery(stolenReportService))
+}
+
+func registerRegistrationHandlers(mediator *api.Mediator, registrationService domainservices.RegistrationService) {
+	api.RegisterCommand[new_registration.NewRegistrationRequest, *new_registration.NewRegistrationResponse](mediator, new_registration.Name, new_registration.CreateNewRegistrationCommand(registrationService))
+	api.RegisterCommand[cancel_registration.CancelRegistrationRequest, *cancel_registration.CancelRegistrationResponse](mediator, cancel_registration.Name, cancel_registration.CreateCancelRegistrationCommand(registrationService))
+	api.RegisterQuery[get_car_registrations.GetCarRegistrationsRequest, []*dto.RegistrationResponse](mediator, get_car_registrations.Name, get_car_registrations.NewGetCarRegistrationsQuery(registrationService))
+}
+
+func registerInspectionHandlers(mediator *api.Mediator, inspectionService domainservices.InspectionService) {
+	api.RegisterCommand[new_inspection.NewInspectionRequest, *new_inspection.NewInspectionResponse](mediator, new_inspection.Name, new_inspection.CreateNewInspectionCommand(inspectionService))
+	api.RegisterQuery[get_car_inspections.GetCarInspectionsRequest, []*dto.InspectionResponse](mediator, get_car_inspections.Name, get_car_inspections.NewGetCarInspectionsQuery(inspectionService))
+	api.RegisterQuery[get_inspection.GetInspectionRequest, *dto.InspectionResponse](mediator, get_inspection.Name, get_inspection.NewGetInspectionQuery(inspectionService))
+	api.RegisterQuery[get_due_inspections.GetDueInspectionsRequest, []*dto.DueInspectionResponse](mediator, get_due_inspections.Name, get_due_inspections.NewGetDueInspectionsQuery(inspectionService))
+}
+
+func registerInsurancePolicyHandlers(mediator *api.Mediator, insurancePolicyService domainservices.InsurancePolicyService) {
+	api.RegisterCommand[new_insurance_policy.NewInsurancePolicyRequest, *new_insurance_policy.NewInsurancePolicyResponse](mediator, new_insurance_policy.Name, new_insurance_policy.CreateNewInsurancePolicyCommand(insurancePolicyService))
+	api.RegisterCommand[terminate_insurance_policy.TerminateInsurancePolicyRequest, *terminate_insurance_policy.TerminateInsurancePolicyResponse](mediator, terminate_insurance_policy.Name, terminate_insurance_policy.CreateTerminateInsurancePolicyCommand(insurancePolicyService))
+	api.RegisterQuery[get_car_insurance_policies.GetCarInsurancePoliciesRequest, []*dto.InsurancePolicyResponse](mediator, get_car_insurance_policies.Name, get_car_insurance_policies.NewGetCarInsurancePoliciesQuery(insurancePolicyService))
+	api.RegisterQuery[get_owner_insurance_policies.GetOwnerInsurancePoliciesRequest, []*dto.InsurancePolicyResponse](mediator, get_owner_insurance_policies.Name, get_owner_insurance_policies.NewGetOwnerInsurancePoliciesQuery(insurancePolicyService))
+	api.RegisterQuery[get_insurance_alerts.GetInsuranceAlertsRequest, []*dto.InsuranceAlertResponse](mediator, get_insurance_alerts.Name, get_insurance_alerts.NewGetInsuranceAlertsQuery(insurancePolicyService))
+}
+
+func registerRecallHandlers(mediator *api.Mediator, recallService domainservices.RecallService) {
+	api.RegisterCommand[new_recall.NewRecallRequest, *new_recall.NewRecallResponse](mediator, new_recall.Name, new_recall.CreateNewRecallCommand(recallService))
+	api.RegisterCommand[complete_recall_remedy.CompleteRecallRemedyRequest, *complete_recall_remedy.CompleteRecallRemedyResponse](mediator, complete_recall_remedy.Name, complete_recall_remedy.CreateCompleteRecallRemedyCommand(recallService))
+	api.RegisterQuery[get_recall.GetRecallRequest, *dto.RecallResponse](mediator, get_recall.Name, get_recall.NewGetRecallQuery(recallService))
+	api.RegisterQuery[get_recall_affected_cars.GetRecallAffectedCarsRequest, []*dto.AffectedCarResponse](mediator, get_recall_affected_cars.Name, get_recall_affected_cars.NewGetRecallAffectedCarsQuery(recallService))
+	api.RegisterQuery[get_car_recalls.GetCarRecallsRequest, []*dto.CarRecallResponse](mediator, get_car_recalls.Name, get_car_recalls.NewGetCarRecallsQuery(recallService))
+}
+
+func registerWarrantyHandlers(mediator *api.Mediator, warrantyService domainservices.WarrantyService) {
+	api.RegisterCommand[new_warranty_template.NewWarrantyTemplateRequest, *new_warranty_template.NewWarrantyTemplateResponse](mediator, new_warranty_template.Name, new_warranty_template.CreateNewWarrantyTemplateCommand(warrantyService))
+	api.RegisterCommand[delete_warranty_template.DeleteWarrantyTemplateRequest, *delete_warranty_template.DeleteWarrantyTemplateResponse](mediator, delete_warranty_template.Name, delete_warranty_template.CreateDeleteWarrantyTemplateCommand(warrantyService))
+	api.RegisterQuery[get_warranty_templates.GetWarrantyTemplatesRequest, []*dto.WarrantyTemplateResponse](mediator, get_warranty_templates.Name, get_warranty_templates.NewGetWarrantyTemplatesQuery(warrantyService))
+	api.RegisterQuery[get_warranty_template.GetWarrantyTemplateRequest, *dto.WarrantyTemplateResponse](mediator, get_warranty_template.Name, get_warranty_template.NewGetWarrantyTemplateQuery(warrantyService))
+	api.RegisterQuery[get_car_warranty.GetCarWarrantyRequest, *dto.CarWarrantyResponse](mediator, get_car_warranty.Name, get_car_warranty.NewGetCarWarrantyQuery(warrantyService))
+}
+
 func setupDatabase(env *config.Environment) (*gorm.DB, error) {
 	// Conectar a la base de datos
-	db, err := gorm.Open(postgres.Open(env.GetDSN()), &gorm.Config{})
+	// TranslateError expone las violaciones de unicidad como gorm.ErrDuplicatedKey
+	db, err := gorm.Open(postgres.Open(env.GetDSN()), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,103 @@
+package mediator
+
+import (
+	"car-service/internal/domain/repositories"
+	"context"
+	"log"
+	"time"
+)
+
+// Orden de los behaviors incluidos por defecto. Los behaviors registrados con un orden menor
+// envuelven a estos (por ejemplo, reintentos fuera de la transacción) y los de orden mayor quedan
+// más cerca del handler.
+const (
+	LoggingOrder     = 100
+	ValidationOrder  = 200
+	TransactionOrder = 300
+)
+
+// LoggingBehavior registra el inicio, el resultado y la duración de cada solicitud
+type LoggingBehavior struct{}
+
+func NewLoggingBehavior() *LoggingBehavior {
+	return &LoggingBehavior{}
+}
+
+func (b *LoggingBehavior) Handle(ctx *CommandContext, info RequestInfo, request any, next RequestHandlerFunc) (any, error) {
+	start := time.Now()
+	log.Printf("Ejecutando %s %s: %T", info.Type, info.Name, info.Handler)
+	result, err := next(ctx, request)
+	if err != nil {
+		log.Printf("%s %s finalizó con error en %s: %v", info.Type, info.Name, time.Since(start), err)
+		return result, err
+	}
+	log.Printf("%s %s finalizó correctamente en %s", info.Type, info.Name, time.Since(start))
+	return result, nil
+}
+
+// ValidationBehavior ejecuta las validaciones de los commands que implementan CommandValidator
+type ValidationBehavior struct{}
+
+func NewValidationBehavior() *ValidationBehavior {
+	return &ValidationBehavior{}
+}
+
+func (b *ValidationBehavior) Handle(ctx *CommandContext, info RequestInfo, request any, next RequestHandlerFunc) (any, error) {
+	if validator, ok := info.Handler.(CommandValidator); ok {
+		if validationErrors := validator.Validate(CommandRequest[any]{Data: request}, ctx); len(validationErrors) > 0 {
+			return nil, ValidationErrors(validationErrors)
+		}
+	}
+	return next(ctx, request)
+}
+
+// TransactionBehavior ejecuta cada command dentro de una unidad de trabajo.
+// Las queries se ejecutan fuera de la transacción.
+type TransactionBehavior struct {
+	uow repositories.UnitOfWork
+}
+
+func NewTransactionBehavior(uow repositories.UnitOfWork) *TransactionBehavior {
+	return &TransactionBehavior{uow: uow}
+}
+
+func (b *TransactionBehavior) Handle(ctx *CommandContext, info RequestInfo, request any, next RequestHandlerFunc) (result any, err error) {
+	if info.Type != Command {
+		return next(ctx, request)
+	}
+
+	parent := ctx.Context
+	txCtx, err := b.uow.Begin(parent)
+	if err != nil {
+		return nil, err
+	}
+	// Los repositorios resuelven la transacción desde el contexto del comando
+	ctx.Context = txCtx
+	defer func() {
+		ctx.Context = parent
+	}()
+
+	defer func() {
+		if r := recover(); r != nil {
+			b.rollback(txCtx)
+			panic(r)
+		}
+	}()
+
+	result, err = next(ctx, request)
+	if err != nil {
+		b.rollback(txCtx)
+		return nil, err
+	}
+
+	if err := b.uow.Commit(txCtx); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (b *TransactionBehavior) rollback(ctx context.Context) {
+	if err := b.uow.Rollback(ctx); err != nil {
+		log.Printf("Error al revertir la transacción: %v", err)
+	}
+}
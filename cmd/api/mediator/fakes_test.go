@@ -0,0 +1,120 @@
+package mediator_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var errNoTransaction = errors.New("no hay una transacción activa en el contexto")
+
+type memoryTxKey struct{}
+
+// memoryTx acumula las escrituras de una transacción hasta que se confirma
+type memoryTx struct {
+	rows map[string]map[any]any
+}
+
+// memoryDB simula una base de datos con aislamiento: las escrituras hechas dentro de una
+// transacción solo son visibles para los contextos que la transportan
+type memoryDB struct {
+	mu   sync.Mutex
+	rows map[string]map[any]any
+}
+
+func newMemoryDB() *memoryDB {
+	return &memoryDB{rows: make(map[string]map[any]any)}
+}
+
+func (db *memoryDB) Save(ctx context.Context, table string, key any, value any) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	rows := db.rows
+	if tx, ok := ctx.Value(memoryTxKey{}).(*memoryTx); ok {
+		rows = tx.rows
+	}
+	if rows[table] == nil {
+		rows[table] = make(map[any]any)
+	}
+	rows[table][key] = value
+}
+
+func (db *memoryDB) Find(ctx context.Context, table string, key any) (any, bool) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if tx, ok := ctx.Value(memoryTxKey{}).(*memoryTx); ok {
+		if value, found := tx.rows[table][key]; found {
+			return value, true
+		}
+	}
+	value, found := db.rows[table][key]
+	return value, found
+}
+
+// Count retorna la cantidad de filas confirmadas de la tabla
+func (db *memoryDB) Count(table string) int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return len(db.rows[table])
+}
+
+// memoryUnitOfWork implementa repositories.UnitOfWork sobre memoryDB
+type memoryUnitOfWork struct {
+	db         *memoryDB
+	mu         sync.Mutex
+	begun      int
+	committed  int
+	rolledBack int
+}
+
+func newMemoryUnitOfWork(db *memoryDB) *memoryUnitOfWork {
+	return &memoryUnitOfWork{db: db}
+}
+
+func (u *memoryUnitOfWork) Begin(ctx context.Context) (context.Context, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.begun++
+	return context.WithValue(ctx, memoryTxKey{}, &memoryTx{rows: make(map[string]map[any]any)}), nil
+}
+
+func (u *memoryUnitOfWork) Commit(ctx context.Context) error {
+	tx, ok := ctx.Value(memoryTxKey{}).(*memoryTx)
+	if !ok {
+		return errNoTransaction
+	}
+	u.db.mu.Lock()
+	for table, rows := range tx.rows {
+		if u.db.rows[table] == nil {
+			u.db.rows[table] = make(map[any]any)
+		}
+		for key, value := range rows {
+			u.db.rows[table][key] = value
+		}
+	}
+	u.db.mu.Unlock()
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.committed++
+	return nil
+}
+
+func (u *memoryUnitOfWork) Rollback(ctx context.Context) error {
+	tx, ok := ctx.Value(memoryTxKey{}).(*memoryTx)
+	if !ok {
+		return errNoTransaction
+	}
+	tx.rows = make(map[string]map[any]any)
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.rolledBack++
+	return nil
+}
+
+func (u *memoryUnitOfWork) Counts() (begun, committed, rolledBack int) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.begun, u.committed, u.rolledBack
+}
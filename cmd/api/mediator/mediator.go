@@ -3,35 +3,37 @@
 package mediator
 
 import (
-	"car-service/cmd/api/response"
-	"car-service/internal/domain/errors"
+	"car-service/internal/domain/repositories"
 	"context"
+	"errors"
 	"fmt"
-	"io"
-	"log"
-	"net/http"
 	"sync"
-
-	"bytes"
-	"encoding/json"
-
-	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
+// ErrHandlerNotFound se retorna cuando no hay un command ni una query registrados con el nombre solicitado
+var ErrHandlerNotFound = errors.New("handler no registrado")
+
+// Mediator despacha commands y queries a sus handlers a través del pipeline de behaviors.
+// No depende de ningún transporte: puede usarse desde HTTP, una CLI, un worker o un test.
 type Mediator struct {
-	commands map[string]CommandHandler[CommandRequest[any], any]
-	queries  map[string]QueryHandler[QueryRequest[any], any]
-	mu       sync.RWMutex
-	db       *gorm.DB
+	commands         map[string]CommandHandler[CommandRequest[any], any]
+	queries          map[string]QueryHandler[QueryRequest[any], any]
+	behaviors        []registeredBehavior
+	handlerBehaviors map[string][]registeredBehavior
+	mu               sync.RWMutex
 }
 
-func NewMediator(db *gorm.DB) *Mediator {
-	return &Mediator{
-		commands: make(map[string]CommandHandler[CommandRequest[any], any]),
-		queries:  make(map[string]QueryHandler[QueryRequest[any], any]),
-		db:       db,
+// NewMediator crea un mediator con el pipeline por defecto: logging, validación y transacción
+func NewMediator(uow repositories.UnitOfWork) *Mediator {
+	m := &Mediator{
+		commands:         make(map[string]CommandHandler[CommandRequest[any], any]),
+		queries:          make(map[string]QueryHandler[QueryRequest[any], any]),
+		handlerBehaviors: make(map[string][]registeredBehavior),
 	}
+	m.AddBehavior(LoggingOrder, NewLoggingBehavior())
+	m.AddBehavior(ValidationOrder, NewValidationBehavior())
+	m.AddBehavior(TransactionOrder, NewTransactionBehavior(uow))
+	return m
 }
 
 func (m *Mediator) RegisterCommand(command string, handler CommandHandler[CommandRequest[any], any]) {
@@ -46,107 +48,42 @@ func (m *Mediator) RegisterQuery(query string, handler QueryHandler[QueryRequest
 	m.queries[query] = handler
 }
 
-func (m *Mediator) LogRequest(c *gin.Context, cmdCtx *CommandContext, requestType any) {
-	log.Printf("Procesando solicitud: Content-Type=%s, Content-Length=%d, Path=%s", c.GetHeader("Content-Type"), c.Request.ContentLength, c.Request.URL.Path)
-	body, err := io.ReadAll(c.Request.Body)
-	if err != nil {
-		log.Printf("Error al leer el cuerpo de la solicitud: %v", err)
-	} else {
-		c.Request.Body = io.NopCloser(bytes.NewBuffer(body))
-		log.Printf("Cuerpo de la solicitud: %s", string(body))
-		if err := json.Unmarshal(body, requestType); err != nil {
-			log.Printf("Error al deserializar JSON: %v", err)
-			return
-		}
-	}
-}
+// Dispatch ejecuta el command o la query registrados con el nombre indicado.
+// Si ctx es un *CommandContext se reutiliza, de modo que quien invoca puede leer las decisiones tomadas.
+// Los panics de handlers y behaviors se retornan como *PanicError.
+func (m *Mediator) Dispatch(ctx context.Context, name string, request any) (result any, err error) {
+	defer recoverPanic(name, &err)
 
-func (m *Mediator) Validate(c CommandRequest[any], command CommandHandler[CommandRequest[any], any], cmdCtx *CommandContext) []string {
-	if validator, ok := command.(CommandValidator); ok {
-		validationErrors := validator.Validate(c, cmdCtx)
-		if len(validationErrors) > 0 {
-			errorMessages := make([]string, len(validationErrors))
-			for i, err := range validationErrors {
-				errorMessages[i] = fmt.Sprintf("%s: %s", err.Field, err.Message)
-			}
-			return errorMessages
-
-		}
-		return nil
-	}
-	return nil
-}
-
-func (m *Mediator) Send(c *gin.Context, actionType string, name string, requestType any) {
-	cmdCtx := &CommandContext{
-		Context:   c.Request.Context(),
-		decisions: []string{},
+	cmdCtx, ok := ctx.(*CommandContext)
+	if !ok {
+		cmdCtx = NewCommandContext(ctx)
 	}
 
-	m.LogRequest(c, cmdCtx, requestType)
-
-	if actionType == Query {
-		m.mu.RLock()
-		selectedQuery := m.queries[name]
-		m.mu.RUnlock()
-		queryRequest := &QueryRequest[any]{Data: requestType}
-		result, err := m.ExecuteQuery(selectedQuery, queryRequest)
-
-		if err != nil {
-			response.JSON(c, http.StatusInternalServerError, "Error al ejecutar query", nil, []string{err.Error()}, cmdCtx.decisions)
-			return
-		} else {
-			response.JSON(c, http.StatusOK, "Operación completada con éxito", result, nil, cmdCtx.decisions)
-			return
-		}
-	} else {
-		m.mu.RLock()
-		selectedCommand := m.commands[name]
-		m.mu.RUnlock()
-		commandRequest := &CommandRequest[any]{Data: requestType}
-
-		validationsErrors := m.Validate(*commandRequest, selectedCommand, cmdCtx)
-		if validationsErrors != nil {
-			response.JSON(c, http.StatusBadRequest,
-				"Bad Request", nil, validationsErrors, cmdCtx.decisions)
-			return
-		} else {
-			result, err := m.ExecuteCommand(selectedCommand, commandRequest, cmdCtx)
-
-			if err != nil {
-				if businessErr, ok := err.(*errors.BusinessError); ok {
-					response.JSON(c, http.StatusConflict,
-						"Error de negocio", nil, []string{businessErr.Message}, cmdCtx.decisions)
-					return
-				}
-
-				response.JSON(c, http.StatusInternalServerError,
-					"Error al ejecutar el comando", nil, []string{err.Error()}, cmdCtx.decisions)
-				return
-			} else {
-				response.JSON(c, http.StatusCreated,
-					"Operación completada con éxito", result, nil, cmdCtx.decisions)
-				return
-			}
-		}
+	m.mu.RLock()
+	selectedCommand, isCommand := m.commands[name]
+	selectedQuery, isQuery := m.queries[name]
+	m.mu.RUnlock()
+
+	switch {
+	case isCommand:
+		info := RequestInfo{Name: name, Type: Command, Handler: selectedCommand}
+		return m.pipeline(info, func(ctx *CommandContext, request any) (any, error) {
+			return m.ExecuteCommand(selectedCommand, &CommandRequest[any]{Data: request}, ctx)
+		})(cmdCtx, request)
+	case isQuery:
+		info := RequestInfo{Name: name, Type: Query, Handler: selectedQuery}
+		return m.pipeline(info, func(ctx *CommandContext, request any) (any, error) {
+			return m.ExecuteQuery(selectedQuery, &QueryRequest[any]{Data: request}, ctx)
+		})(cmdCtx, request)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrHandlerNotFound, name)
 	}
 }
 
-func (m *Mediator) ExecuteQuery(query QueryHandler[QueryRequest[any], any], data *QueryRequest[any]) (any, error) {
-	log.Printf("Executing query: %T", query)
-
-	var panicErr error
-	defer func() {
-		if r := recover(); r != nil {
-			panicErr = fmt.Errorf("panic: %v", r)
-		}
-	}()
-
-	if panicErr != nil {
-		return nil, panicErr
-	}
+func (m *Mediator) ExecuteQuery(query QueryHandler[QueryRequest[any], any], data *QueryRequest[any], ctx *CommandContext) (result any, err error) {
+	defer recoverPanic(fmt.Sprintf("%T", query), &err)
 
-	reponse, err := query.Execute(*data, context.Background())
+	reponse, err := query.Execute(*data, ctx.Context)
 	if err != nil {
 		return nil, err
 	}
@@ -154,31 +91,10 @@ func (m *Mediator) ExecuteQuery(query QueryHandler[QueryRequest[any], any], data
 	return reponse, nil
 }
 
-func (m *Mediator) ExecuteCommand(command CommandHandler[CommandRequest[any], any], data *CommandRequest[any], ctx *CommandContext) (any, error) {
-	log.Printf("Executing Command: %T", command)
-	tx := m.db.Begin()
-
-	var panicErr error
-	defer func() {
-		if r := recover(); r != nil {
-			panicErr = fmt.Errorf("panic: %v", r)
-			tx.Rollback()
-		}
-	}()
-
-	if panicErr != nil {
-		return nil, panicErr
-	}
-
-	response, err := command.Execute(*data, &ctx.Context)
-	if err != nil {
-		tx.Rollback()
-		return nil, err
-	}
-
-	if err := tx.Commit().Error; err != nil {
-		return nil, err
-	}
+// ExecuteCommand ejecuta el command; un panic se convierte en *PanicError para que
+// el TransactionBehavior revierta la transacción
+func (m *Mediator) ExecuteCommand(command CommandHandler[CommandRequest[any], any], data *CommandRequest[any], ctx *CommandContext) (result any, err error) {
+	defer recoverPanic(fmt.Sprintf("%T", command), &err)
 
-	return response, nil
+	return command.Execute(*data, &ctx.Context)
 }
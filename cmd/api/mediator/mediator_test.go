@@ -0,0 +1,67 @@
+package mediator_test
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/decisions"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// greetQuery arma un saludo y registra la decisión tomada
+type greetQuery struct{}
+
+func (q *greetQuery) Execute(request api.QueryRequest[recordingRequest], ctx context.Context) (string, error) {
+	decisions.Record(ctx, "Saludo generado para "+request.Data.Value)
+	return "hola " + request.Data.Value, nil
+}
+
+func TestDispatchWithoutTransport(t *testing.T) {
+	captureLog(t, &trace{})
+	steps := &trace{}
+	mediator := api.NewMediator(&tracingUnitOfWork{trace: steps})
+	api.RegisterCommand[recordingRequest, string](mediator, "Record", &recordingCommand{trace: steps})
+	api.RegisterQuery[recordingRequest, string](mediator, "Greet", &greetQuery{})
+
+	// Un contexto cualquiera alcanza para despachar
+	result, err := mediator.Dispatch(context.Background(), "Record", &recordingRequest{Value: "ok"})
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if result != "ok" {
+		t.Errorf("resultado = %v, se esperaba ok", result)
+	}
+	if expected := []string{"validate", "begin", "handler", "commit"}; !reflect.DeepEqual(steps.Steps(), expected) {
+		t.Errorf("pasos = %v, se esperaba %v", steps.Steps(), expected)
+	}
+
+	// Con un CommandContext quien invoca puede leer las decisiones
+	cmdCtx := api.NewCommandContext(context.Background())
+	result, err = mediator.Dispatch(cmdCtx, "Greet", recordingRequest{Value: "mundo"})
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if result != "hola mundo" {
+		t.Errorf("resultado = %v, se esperaba hola mundo", result)
+	}
+	if expected := []string{"Saludo generado para mundo"}; !reflect.DeepEqual(cmdCtx.Decisions(), expected) {
+		t.Errorf("decisiones = %v, se esperaba %v", cmdCtx.Decisions(), expected)
+	}
+}
+
+func TestDispatchUnknownHandler(t *testing.T) {
+	mediator := api.NewMediator(&tracingUnitOfWork{trace: &trace{}})
+
+	result, err := mediator.Dispatch(context.Background(), "Missing", recordingRequest{})
+	if result != nil {
+		t.Errorf("no se esperaba resultado, se obtuvo %v", result)
+	}
+	if !errors.Is(err, api.ErrHandlerNotFound) {
+		t.Fatalf("se esperaba ErrHandlerNotFound, se obtuvo %v", err)
+	}
+	if !strings.Contains(err.Error(), "Missing") {
+		t.Errorf("el error debería nombrar al handler: %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+package mediator
+
+import "sort"
+
+// RequestInfo describe la solicitud que atraviesa el pipeline
+type RequestInfo struct {
+	Name    string // Nombre con el que se registró el handler
+	Type    string // Query o Command
+	Handler any    // Handler registrado para la solicitud
+}
+
+// RequestHandlerFunc representa el siguiente paso del pipeline
+type RequestHandlerFunc func(ctx *CommandContext, request any) (any, error)
+
+// PipelineBehavior envuelve la ejecución de un handler, permitiendo lógica previa y posterior
+// (logging, métricas, caché, autorización, reintentos, etc.)
+type PipelineBehavior interface {
+	Handle(ctx *CommandContext, info RequestInfo, request any, next RequestHandlerFunc) (any, error)
+}
+
+// BehaviorFunc permite usar una función como PipelineBehavior
+type BehaviorFunc func(ctx *CommandContext, info RequestInfo, request any, next RequestHandlerFunc) (any, error)
+
+func (f BehaviorFunc) Handle(ctx *CommandContext, info RequestInfo, request any, next RequestHandlerFunc) (any, error) {
+	return f(ctx, info, request, next)
+}
+
+// PreProcessor crea un behavior que se ejecuta antes del handler; si retorna error el handler no se ejecuta
+func PreProcessor(process func(ctx *CommandContext, info RequestInfo, request any) error) PipelineBehavior {
+	return BehaviorFunc(func(ctx *CommandContext, info RequestInfo, request any, next RequestHandlerFunc) (any, error) {
+		if err := process(ctx, info, request); err != nil {
+			return nil, err
+		}
+		return next(ctx, request)
+	})
+}
+
+// PostProcessor crea un behavior que se ejecuta luego del handler y puede inspeccionar o reemplazar su resultado
+func PostProcessor(process func(ctx *CommandContext, info RequestInfo, result any, err error) (any, error)) PipelineBehavior {
+	return BehaviorFunc(func(ctx *CommandContext, info RequestInfo, request any, next RequestHandlerFunc) (any, error) {
+		result, err := next(ctx, request)
+		return process(ctx, info, result, err)
+	})
+}
+
+type registeredBehavior struct {
+	behavior PipelineBehavior
+	order    int
+}
+
+// AddBehavior registra un behavior que se aplica a todos los handlers.
+// Los behaviors se ejecutan en orden ascendente; los de igual orden, en el orden en que fueron registrados.
+func (m *Mediator) AddBehavior(order int, behavior PipelineBehavior) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.behaviors = append(m.behaviors, registeredBehavior{behavior: behavior, order: order})
+}
+
+// AddHandlerBehavior registra un behavior que se aplica únicamente al handler indicado
+func (m *Mediator) AddHandlerBehavior(name string, order int, behavior PipelineBehavior) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handlerBehaviors[name] = append(m.handlerBehaviors[name], registeredBehavior{behavior: behavior, order: order})
+}
+
+// pipeline compone los behaviors globales y los del handler alrededor del paso final
+func (m *Mediator) pipeline(info RequestInfo, final RequestHandlerFunc) RequestHandlerFunc {
+	m.mu.RLock()
+	behaviors := make([]registeredBehavior, 0, len(m.behaviors)+len(m.handlerBehaviors[info.Name]))
+	behaviors = append(behaviors, m.behaviors...)
+	behaviors = append(behaviors, m.handlerBehaviors[info.Name]...)
+	m.mu.RUnlock()
+
+	sort.SliceStable(behaviors, func(i, j int) bool {
+		return behaviors[i].order < behaviors[j].order
+	})
+
+	next := final
+	for i := len(behaviors) - 1; i >= 0; i-- {
+		behavior := behaviors[i].behavior
+		inner := next
+		next = func(ctx *CommandContext, request any) (any, error) {
+			return behavior.Handle(ctx, info, request, inner)
+		}
+	}
+	return next
+}
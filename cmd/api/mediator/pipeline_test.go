@@ -0,0 +1,207 @@
+package mediator_test
+
+import (
+	api "car-service/cmd/api/mediator"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// trace registra los pasos del pipeline en el orden en que se ejecutan
+type trace struct {
+	mu    sync.Mutex
+	steps []string
+}
+
+func (t *trace) Add(step string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.steps = append(t.steps, step)
+}
+
+func (t *trace) Steps() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.steps...)
+}
+
+// Write permite usar el trace como salida del log para ubicar al LoggingBehavior
+func (t *trace) Write(p []byte) (int, error) {
+	t.Add("log")
+	return len(p), nil
+}
+
+func captureLog(t *testing.T, w *trace) {
+	log.SetOutput(w)
+	t.Cleanup(func() {
+		log.SetOutput(os.Stderr)
+	})
+}
+
+// tracingUnitOfWork registra el inicio y el cierre de las transacciones
+type tracingUnitOfWork struct {
+	trace *trace
+}
+
+func (u *tracingUnitOfWork) Begin(ctx context.Context) (context.Context, error) {
+	u.trace.Add("begin")
+	return ctx, nil
+}
+
+func (u *tracingUnitOfWork) Commit(ctx context.Context) error {
+	u.trace.Add("commit")
+	return nil
+}
+
+func (u *tracingUnitOfWork) Rollback(ctx context.Context) error {
+	u.trace.Add("rollback")
+	return nil
+}
+
+type recordingRequest struct {
+	Value string
+}
+
+// recordingCommand registra su validación y ejecución en el trace
+type recordingCommand struct {
+	trace            *trace
+	validationErrors []*api.ValidationError
+}
+
+func (c *recordingCommand) Validate(request api.CommandRequest[recordingRequest], ctx *api.CommandContext) []*api.ValidationError {
+	c.trace.Add("validate")
+	return c.validationErrors
+}
+
+func (c *recordingCommand) Execute(request api.CommandRequest[recordingRequest], ctx *context.Context) (string, error) {
+	c.trace.Add("handler")
+	return request.Data.Value, nil
+}
+
+func tracingBehavior(t *trace, name string) api.PipelineBehavior {
+	return api.BehaviorFunc(func(ctx *api.CommandContext, info api.RequestInfo, request any, next api.RequestHandlerFunc) (any, error) {
+		t.Add("before-" + name)
+		result, err := next(ctx, request)
+		t.Add("after-" + name)
+		return result, err
+	})
+}
+
+func TestPipelineRunsBehaviorsByOrder(t *testing.T) {
+	steps := &trace{}
+	captureLog(t, steps)
+	mediator := api.NewMediator(&tracingUnitOfWork{trace: steps})
+	api.RegisterCommand[recordingRequest, string](mediator, "Record", &recordingCommand{trace: steps})
+
+	// Se registran desordenados para comprobar que el pipeline los ordena
+	mediator.AddBehavior(api.TransactionOrder+50, tracingBehavior(steps, "350"))
+	mediator.AddHandlerBehavior("Record", api.ValidationOrder+50, tracingBehavior(steps, "250"))
+	mediator.AddBehavior(api.LoggingOrder-50, tracingBehavior(steps, "50"))
+	mediator.AddBehavior(api.ValidationOrder-50, tracingBehavior(steps, "150"))
+
+	result, err := mediator.Dispatch(context.Background(), "Record", recordingRequest{Value: "ok"})
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if result != "ok" {
+		t.Errorf("resultado = %v, se esperaba ok", result)
+	}
+
+	expected := []string{
+		"before-50",
+		"log", // LoggingBehavior (100)
+		"before-150",
+		"validate", // ValidationBehavior (200)
+		"before-250",
+		"begin", // TransactionBehavior (300)
+		"before-350",
+		"handler",
+		"after-350",
+		"commit",
+		"after-250",
+		"after-150",
+		"log",
+		"after-50",
+	}
+	if steps := steps.Steps(); !reflect.DeepEqual(steps, expected) {
+		t.Errorf("orden del pipeline:\n  obtenido  %v\n  esperado  %v", steps, expected)
+	}
+}
+
+func TestPipelineKeepsRegistrationOrderForEqualOrders(t *testing.T) {
+	steps := &trace{}
+	captureLog(t, &trace{})
+	mediator := api.NewMediator(&tracingUnitOfWork{trace: &trace{}})
+	api.RegisterCommand[recordingRequest, string](mediator, "Record", &recordingCommand{trace: &trace{}})
+
+	for i := 1; i <= 3; i++ {
+		mediator.AddBehavior(api.TransactionOrder+1, tracingBehavior(steps, fmt.Sprint(i)))
+	}
+
+	if _, err := mediator.Dispatch(context.Background(), "Record", recordingRequest{}); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	expected := []string{"before-1", "before-2", "before-3", "after-3", "after-2", "after-1"}
+	if steps := steps.Steps(); !reflect.DeepEqual(steps, expected) {
+		t.Errorf("orden = %v, se esperaba %v", steps, expected)
+	}
+}
+
+func TestHandlerBehaviorAppliesOnlyToItsHandler(t *testing.T) {
+	steps := &trace{}
+	captureLog(t, &trace{})
+	mediator := api.NewMediator(&tracingUnitOfWork{trace: &trace{}})
+	api.RegisterCommand[recordingRequest, string](mediator, "Audited", &recordingCommand{trace: &trace{}})
+	api.RegisterCommand[recordingRequest, string](mediator, "Plain", &recordingCommand{trace: &trace{}})
+
+	mediator.AddHandlerBehavior("Audited", api.TransactionOrder+10, api.PreProcessor(func(ctx *api.CommandContext, info api.RequestInfo, request any) error {
+		steps.Add(info.Name)
+		return nil
+	}))
+
+	for _, name := range []string{"Plain", "Audited", "Plain"} {
+		if _, err := mediator.Dispatch(context.Background(), name, recordingRequest{}); err != nil {
+			t.Fatalf("%s: error inesperado: %v", name, err)
+		}
+	}
+	if steps := steps.Steps(); !reflect.DeepEqual(steps, []string{"Audited"}) {
+		t.Errorf("el behavior se ejecutó para %v, se esperaba solo Audited", steps)
+	}
+}
+
+func TestValidationErrorsShortCircuitPipeline(t *testing.T) {
+	steps := &trace{}
+	captureLog(t, &trace{})
+	mediator := api.NewMediator(&tracingUnitOfWork{trace: steps})
+	command := &recordingCommand{
+		trace:            steps,
+		validationErrors: []*api.ValidationError{{Field: "value", Message: "El valor es requerido"}},
+	}
+	api.RegisterCommand[recordingRequest, string](mediator, "Record", command)
+	mediator.AddBehavior(api.ValidationOrder-50, tracingBehavior(steps, "150"))
+	mediator.AddBehavior(api.TransactionOrder+50, tracingBehavior(steps, "350"))
+
+	result, err := mediator.Dispatch(context.Background(), "Record", recordingRequest{})
+	if result != nil {
+		t.Errorf("no se esperaba resultado, se obtuvo %v", result)
+	}
+
+	var validationErrors api.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		t.Fatalf("se esperaba ValidationErrors, se obtuvo %v", err)
+	}
+	if messages := validationErrors.Messages(); !reflect.DeepEqual(messages, []string{"value: El valor es requerido"}) {
+		t.Errorf("mensajes = %v", messages)
+	}
+
+	// Ni la transacción, ni los behaviors posteriores, ni el handler llegan a ejecutarse
+	expected := []string{"before-150", "validate", "after-150"}
+	if steps := steps.Steps(); !reflect.DeepEqual(steps, expected) {
+		t.Errorf("pasos = %v, se esperaba %v", steps, expected)
+	}
+}
@@ -0,0 +1,30 @@
+package mediator
+
+import (
+	"fmt"
+	"log"
+	"runtime/debug"
+)
+
+// PanicErrorCode identifica en las respuestas a los errores originados por un panic
+const PanicErrorCode = "INTERNAL_PANIC"
+
+// PanicError representa un panic recuperado durante la ejecución de un handler
+type PanicError struct {
+	Handler string
+	Value   any
+	Stack   []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic en %s: %v", e.Handler, e.Value)
+}
+
+// recoverPanic convierte un panic en un *PanicError; debe invocarse con defer
+func recoverPanic(handler string, err *error) {
+	if r := recover(); r != nil {
+		panicErr := &PanicError{Handler: handler, Value: r, Stack: debug.Stack()}
+		log.Printf("%v\n%s", panicErr, panicErr.Stack)
+		*err = panicErr
+	}
+}
@@ -0,0 +1,34 @@
+package mediator_test
+
+import (
+	api "car-service/cmd/api/mediator"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBehaviorPanicInsideTransactionRollsBack(t *testing.T) {
+	captureLog(t, &trace{})
+	steps := &trace{}
+	mediator := newTypedMediator(steps)
+	mediator.AddHandlerBehavior("Record", api.TransactionOrder+10, api.PreProcessor(func(ctx *api.CommandContext, info api.RequestInfo, request any) error {
+		panic("behavior roto")
+	}))
+
+	result, err := mediator.Dispatch(context.Background(), "Record", recordingRequest{Value: "ok"})
+	if result != nil {
+		t.Errorf("no se esperaba resultado, se obtuvo %v", result)
+	}
+
+	var panicErr *api.PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("se esperaba PanicError, se obtuvo %T: %v", err, err)
+	}
+	if panicErr.Value != "behavior roto" || len(panicErr.Stack) == 0 {
+		t.Errorf("PanicError incompleto: valor=%v, stack=%d bytes", panicErr.Value, len(panicErr.Stack))
+	}
+	if expected := []string{"validate", "begin", "rollback"}; !reflect.DeepEqual(steps.Steps(), expected) {
+		t.Errorf("pasos = %v, se esperaba %v", steps.Steps(), expected)
+	}
+}
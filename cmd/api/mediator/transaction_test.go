@@ -0,0 +1,168 @@
+package mediator_test
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/commands/new_car"
+	"car-service/internal/application/dto"
+	"car-service/internal/application/queries/get_car"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	carsTable      = "cars"
+	ownershipTable = "ownership_records"
+)
+
+// memoryCarService persiste el vehículo y luego su registro de propiedad, como CarServiceImpl.
+// Solo implementa los métodos que usan new_car y get_car.
+type memoryCarService struct {
+	services.CarService
+	db           *memoryDB
+	ownershipErr error
+	afterCarSave func(ctx context.Context, id uuid.UUID)
+}
+
+func (s *memoryCarService) CreateCar(ctx context.Context, car *entities.Car) (*entities.Car, error) {
+	car.ID = uuid.New()
+	car.CreatedAt = time.Now()
+	s.db.Save(ctx, carsTable, car.ID, *car)
+
+	if s.afterCarSave != nil {
+		s.afterCarSave(ctx, car.ID)
+	}
+
+	if s.ownershipErr != nil {
+		return nil, s.ownershipErr
+	}
+	s.db.Save(ctx, ownershipTable, car.ID, car.OwnerID)
+	return car, nil
+}
+
+func (s *memoryCarService) GetCarDetail(ctx context.Context, id uuid.UUID, relations repositories.CarRelations) (*entities.Car, error) {
+	value, ok := s.db.Find(ctx, carsTable, id)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	car := value.(entities.Car)
+	return &car, nil
+}
+
+func newCarMediator(service *memoryCarService, uow repositories.UnitOfWork) *api.Mediator {
+	mediator := api.NewMediator(uow)
+	api.RegisterCommand[new_car.NewCarRequest, *new_car.NewCarResponse](mediator, new_car.Name, new_car.CreateNewCarCommand(service))
+	api.RegisterQuery[get_car.GetCarRequest, *dto.CarResponse](mediator, get_car.Name, get_car.NewGetCarQuery(service))
+	return mediator
+}
+
+func validNewCarRequest() *new_car.NewCarRequest {
+	return &new_car.NewCarRequest{
+		ModelId: uuid.New(),
+		OwnerId: uuid.New(),
+		Year:    2020,
+		Color:   "Rojo",
+		Vin:     "1HGCM82633A004352",
+	}
+}
+
+func TestTransactionBehaviorRollsBackWhenCommandFailsAfterFirstWrite(t *testing.T) {
+	db := newMemoryDB()
+	uow := newMemoryUnitOfWork(db)
+	ownershipErr := errors.New("ownership_records: conexión perdida")
+	service := &memoryCarService{db: db, ownershipErr: ownershipErr}
+	mediator := newCarMediator(service, uow)
+
+	var carID uuid.UUID
+	var visibleInsideTx, visibleOutsideTx bool
+	var outsideErr error
+	service.afterCarSave = func(ctx context.Context, id uuid.UUID) {
+		carID = id
+		_, visibleInsideTx = db.Find(ctx, carsTable, id)
+		// Una query despachada fuera del contexto del command no debe ver la transacción abierta
+		result, err := mediator.Dispatch(context.Background(), get_car.Name, &get_car.GetCarRequest{ID: id})
+		visibleOutsideTx = result != nil
+		outsideErr = err
+	}
+
+	result, err := mediator.Dispatch(context.Background(), new_car.Name, validNewCarRequest())
+	if !errors.Is(err, ownershipErr) {
+		t.Fatalf("se esperaba el error del segundo repositorio, se obtuvo %v", err)
+	}
+	if result != nil {
+		t.Fatalf("no se esperaba resultado, se obtuvo %v", result)
+	}
+
+	if !visibleInsideTx {
+		t.Error("el vehículo debería ser visible dentro de la transacción del command")
+	}
+	if visibleOutsideTx || !errors.Is(outsideErr, gorm.ErrRecordNotFound) {
+		t.Errorf("la query fuera del command no debería ver la transacción: resultado=%v, error=%v", visibleOutsideTx, outsideErr)
+	}
+
+	if count := db.Count(carsTable); count != 0 {
+		t.Errorf("no deberían persistir vehículos, hay %d", count)
+	}
+	if count := db.Count(ownershipTable); count != 0 {
+		t.Errorf("no deberían persistir registros de propiedad, hay %d", count)
+	}
+	if begun, committed, rolledBack := uow.Counts(); begun != 1 || committed != 0 || rolledBack != 1 {
+		t.Errorf("transacciones: iniciadas=%d confirmadas=%d revertidas=%d; se esperaba 1/0/1", begun, committed, rolledBack)
+	}
+
+	_, err = mediator.Dispatch(context.Background(), get_car.Name, &get_car.GetCarRequest{ID: carID})
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("el vehículo revertido no debería existir, se obtuvo %v", err)
+	}
+}
+
+func TestTransactionBehaviorCommitsWhenCommandSucceeds(t *testing.T) {
+	db := newMemoryDB()
+	uow := newMemoryUnitOfWork(db)
+	service := &memoryCarService{db: db}
+	mediator := newCarMediator(service, uow)
+
+	created, err := api.SendTyped[*new_car.NewCarRequest, *new_car.NewCarResponse](context.Background(), mediator, new_car.Name, validNewCarRequest())
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+
+	if count := db.Count(carsTable); count != 1 {
+		t.Errorf("se esperaba 1 vehículo persistido, hay %d", count)
+	}
+	if count := db.Count(ownershipTable); count != 1 {
+		t.Errorf("se esperaba 1 registro de propiedad persistido, hay %d", count)
+	}
+	if begun, committed, rolledBack := uow.Counts(); begun != 1 || committed != 1 || rolledBack != 0 {
+		t.Errorf("transacciones: iniciadas=%d confirmadas=%d revertidas=%d; se esperaba 1/1/0", begun, committed, rolledBack)
+	}
+
+	car, err := api.SendTyped[*get_car.GetCarRequest, *dto.CarResponse](context.Background(), mediator, get_car.Name, &get_car.GetCarRequest{ID: uuid.MustParse(created.ID)})
+	if err != nil {
+		t.Fatalf("el vehículo confirmado debería existir: %v", err)
+	}
+	if car.VIN != created.VIN {
+		t.Errorf("VIN = %q, se esperaba %q", car.VIN, created.VIN)
+	}
+}
+
+func TestTransactionBehaviorSkipsQueries(t *testing.T) {
+	db := newMemoryDB()
+	uow := newMemoryUnitOfWork(db)
+	mediator := newCarMediator(&memoryCarService{db: db}, uow)
+
+	_, err := mediator.Dispatch(context.Background(), get_car.Name, &get_car.GetCarRequest{ID: uuid.New()})
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("se esperaba ErrRecordNotFound, se obtuvo %v", err)
+	}
+	if begun, _, _ := uow.Counts(); begun != 0 {
+		t.Errorf("las queries no deberían abrir transacciones, se abrieron %d", begun)
+	}
+}
@@ -0,0 +1,97 @@
+package mediator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// TypeMismatchError se retorna cuando la solicitud o la respuesta no coinciden con los tipos del handler registrado
+type TypeMismatchError struct {
+	Handler  string
+	Expected string
+	Actual   string
+}
+
+func (e *TypeMismatchError) Error() string {
+	return fmt.Sprintf("tipo inválido para %s: se esperaba %s y se recibió %s", e.Handler, e.Expected, e.Actual)
+}
+
+// RegisterCommand registra un command tipado. La solicitud puede despacharse como TReq o *TReq.
+func RegisterCommand[TReq any, TRes any](m *Mediator, name string, handler CommandHandler[CommandRequest[TReq], TRes]) {
+	m.RegisterCommand(name, &typedCommand[TReq, TRes]{name: name, handler: handler})
+}
+
+// RegisterQuery registra una query tipada. La solicitud puede despacharse como TReq o *TReq.
+func RegisterQuery[TReq any, TRes any](m *Mediator, name string, handler QueryHandler[QueryRequest[TReq], TRes]) {
+	m.RegisterQuery(name, &typedQuery[TReq, TRes]{name: name, handler: handler})
+}
+
+// SendTyped despacha una solicitud y retorna el resultado con el tipo de respuesta del handler
+func SendTyped[TReq any, TRes any](ctx context.Context, m *Mediator, name string, request TReq) (TRes, error) {
+	var zero TRes
+	result, err := m.Dispatch(ctx, name, request)
+	if err != nil {
+		return zero, err
+	}
+	if result == nil {
+		return zero, nil
+	}
+	typed, ok := result.(TRes)
+	if !ok {
+		return zero, &TypeMismatchError{Handler: name, Expected: typeName[TRes](), Actual: fmt.Sprintf("%T", result)}
+	}
+	return typed, nil
+}
+
+type typedCommand[TReq any, TRes any] struct {
+	name    string
+	handler CommandHandler[CommandRequest[TReq], TRes]
+}
+
+func (c *typedCommand[TReq, TRes]) Execute(request CommandRequest[any], ctx *context.Context) (any, error) {
+	data, err := castRequest[TReq](c.name, request.Data)
+	if err != nil {
+		return nil, err
+	}
+	return c.handler.Execute(CommandRequest[TReq]{Data: data}, ctx)
+}
+
+// Validate omite la validación si el tipo no coincide; Execute reporta el TypeMismatchError
+func (c *typedCommand[TReq, TRes]) Validate(request CommandRequest[any], ctx *CommandContext) []*ValidationError {
+	data, err := castRequest[TReq](c.name, request.Data)
+	if err != nil {
+		return nil
+	}
+	return c.handler.Validate(CommandRequest[TReq]{Data: data}, ctx)
+}
+
+type typedQuery[TReq any, TRes any] struct {
+	name    string
+	handler QueryHandler[QueryRequest[TReq], TRes]
+}
+
+func (q *typedQuery[TReq, TRes]) Execute(request QueryRequest[any], ctx context.Context) (any, error) {
+	data, err := castRequest[TReq](q.name, request.Data)
+	if err != nil {
+		return nil, err
+	}
+	return q.handler.Execute(QueryRequest[TReq]{Data: data}, ctx)
+}
+
+func castRequest[TReq any](name string, data any) (TReq, error) {
+	switch value := data.(type) {
+	case TReq:
+		return value, nil
+	case *TReq:
+		if value != nil {
+			return *value, nil
+		}
+	}
+	var zero TReq
+	return zero, &TypeMismatchError{Handler: name, Expected: typeName[TReq](), Actual: fmt.Sprintf("%T", data)}
+}
+
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
@@ -0,0 +1,110 @@
+package mediator_test
+
+import (
+	api "car-service/cmd/api/mediator"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type otherRequest struct {
+	Value int
+}
+
+func newTypedMediator(steps *trace) *api.Mediator {
+	mediator := api.NewMediator(&tracingUnitOfWork{trace: steps})
+	api.RegisterCommand[recordingRequest, string](mediator, "Record", &recordingCommand{trace: steps})
+	api.RegisterQuery[recordingRequest, string](mediator, "Greet", &greetQuery{})
+	return mediator
+}
+
+func TestTypedHandlersAcceptValueAndPointer(t *testing.T) {
+	captureLog(t, &trace{})
+	mediator := newTypedMediator(&trace{})
+
+	for _, request := range []any{recordingRequest{Value: "ok"}, &recordingRequest{Value: "ok"}} {
+		result, err := mediator.Dispatch(context.Background(), "Record", request)
+		if err != nil {
+			t.Fatalf("%T: error inesperado: %v", request, err)
+		}
+		if result != "ok" {
+			t.Errorf("%T: resultado = %v, se esperaba ok", request, result)
+		}
+	}
+}
+
+func TestTypedHandlersRejectWrongRequestType(t *testing.T) {
+	captureLog(t, &trace{})
+
+	tests := []struct {
+		name    string
+		handler string
+		request any
+		actual  string
+	}{
+		{name: "command con otro struct", handler: "Record", request: otherRequest{Value: 1}, actual: "mediator_test.otherRequest"},
+		{name: "command con puntero nil", handler: "Record", request: (*recordingRequest)(nil), actual: "*mediator_test.recordingRequest"},
+		{name: "command sin solicitud", handler: "Record", request: nil, actual: "<nil>"},
+		{name: "query con otro tipo", handler: "Greet", request: "mundo", actual: "string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps := &trace{}
+			mediator := newTypedMediator(steps)
+
+			result, err := mediator.Dispatch(context.Background(), tt.handler, tt.request)
+			if result != nil {
+				t.Errorf("no se esperaba resultado, se obtuvo %v", result)
+			}
+
+			var mismatch *api.TypeMismatchError
+			if !errors.As(err, &mismatch) {
+				t.Fatalf("se esperaba TypeMismatchError, se obtuvo %T: %v", err, err)
+			}
+			expected := api.TypeMismatchError{Handler: tt.handler, Expected: "mediator_test.recordingRequest", Actual: tt.actual}
+			if *mismatch != expected {
+				t.Errorf("error = %+v, se esperaba %+v", *mismatch, expected)
+			}
+
+			// El handler no se ejecuta y la transacción del command se revierte
+			if tt.handler == "Record" {
+				if expected := []string{"begin", "rollback"}; !reflect.DeepEqual(steps.Steps(), expected) {
+					t.Errorf("pasos = %v, se esperaba %v", steps.Steps(), expected)
+				}
+			}
+		})
+	}
+}
+
+func TestSendTypedRejectsWrongResponseType(t *testing.T) {
+	captureLog(t, &trace{})
+	mediator := newTypedMediator(&trace{})
+
+	result, err := api.SendTyped[recordingRequest, int](context.Background(), mediator, "Greet", recordingRequest{Value: "mundo"})
+	if result != 0 {
+		t.Errorf("se esperaba el valor cero, se obtuvo %v", result)
+	}
+
+	var mismatch *api.TypeMismatchError
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("se esperaba TypeMismatchError, se obtuvo %T: %v", err, err)
+	}
+	expected := api.TypeMismatchError{Handler: "Greet", Expected: "int", Actual: "string"}
+	if *mismatch != expected {
+		t.Errorf("error = %+v, se esperaba %+v", *mismatch, expected)
+	}
+}
+
+func TestSendTypedReturnsTypedResult(t *testing.T) {
+	captureLog(t, &trace{})
+	mediator := newTypedMediator(&trace{})
+
+	result, err := api.SendTyped[recordingRequest, string](context.Background(), mediator, "Greet", recordingRequest{Value: "mundo"})
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if result != "hola mundo" {
+		t.Errorf("resultado = %q, se esperaba hola mundo", result)
+	}
+}
@@ -1,10 +1,9 @@
 package mediator
 
 import (
+	"car-service/internal/domain/decisions"
 	"context"
 	"sync"
-
-	"github.com/gin-gonic/gin"
 )
 
 const Query = "query"
@@ -20,21 +19,42 @@ type QueryRequest[TResponse any] struct {
 
 type CommandHandler[T any, R any] interface {
 	Execute(request T, ctx *context.Context) (R, error)
-	Validate(c *gin.Context, ctx *CommandContext) []*ValidationError
+	Validate(request T, ctx *CommandContext) []*ValidationError
 }
 
 type QueryHandler[T any, R any] interface {
 	Execute(request T, ctx context.Context) (R, error)
 }
 
+// CommandContext acompaña a una solicitud durante todo el pipeline y acumula las decisiones tomadas
 type CommandContext struct {
 	context.Context
 	decisions []string
 	mu        sync.Mutex
 }
 
+// NewCommandContext crea un CommandContext a partir de un contexto cualquiera.
+// El contexto interno transporta al propio CommandContext como decisions.Recorder, de modo
+// que los servicios puedan registrar decisiones sin depender del mediator.
+func NewCommandContext(ctx context.Context) *CommandContext {
+	c := &CommandContext{
+		decisions: []string{},
+	}
+	c.Context = decisions.WithRecorder(ctx, c)
+	return c
+}
+
 func (c *CommandContext) AddDecision(decision string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	c.decisions = append(c.decisions, decision)
 }
+
+// Decisions retorna una copia de las decisiones registradas
+func (c *CommandContext) Decisions() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	decisions := make([]string, len(c.decisions))
+	copy(decisions, c.decisions)
+	return decisions
+}
@@ -1,5 +1,10 @@
 package mediator
 
+import (
+	"fmt"
+	"strings"
+)
+
 type ValidationError struct {
 	Field   string
 	Message string
@@ -9,6 +14,22 @@ func (e *ValidationError) Error() string {
 	return e.Message
 }
 
+// ValidationErrors agrupa los errores de validación de una solicitud
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	return strings.Join(e.Messages(), "; ")
+}
+
+// Messages retorna los errores con el formato "campo: mensaje"
+func (e ValidationErrors) Messages() []string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = fmt.Sprintf("%s: %s", err.Field, err.Message)
+	}
+	return messages
+}
+
 type CommandValidator interface {
 	Validate(request CommandRequest[any], ctx *CommandContext) []*ValidationError
 }
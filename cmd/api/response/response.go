@@ -5,9 +5,11 @@ import "github.com/gin-gonic/gin"
 
 type StandardResponse struct {
 	Message   string      `json:"message"`
+	Code      string      `json:"code,omitempty"`
 	Errors    []string    `json:"errors,omitempty"`
 	Decisions []string    `json:"decisions,omitempty"`
 	Data      interface{} `json:"data,omitempty"`
+	Meta      interface{} `json:"meta,omitempty"`
 }
 
 func JSON(c *gin.Context, httpStatus int, message string,
@@ -22,3 +24,31 @@ func JSON(c *gin.Context, httpStatus int, message string,
 
 	c.JSON(httpStatus, response)
 }
+
+// PagedJSON escribe una respuesta con una página de resultados y sus metadatos de paginación
+func PagedJSON(c *gin.Context, httpStatus int, message string,
+	data interface{}, meta interface{}, decisions []string) {
+
+	response := StandardResponse{
+		Message:   message,
+		Decisions: decisions,
+		Data:      data,
+		Meta:      meta,
+	}
+
+	c.JSON(httpStatus, response)
+}
+
+// ErrorJSON escribe una respuesta de error identificada por un código
+func ErrorJSON(c *gin.Context, httpStatus int, code string, message string,
+	errors []string, decisions []string) {
+
+	response := StandardResponse{
+		Message:   message,
+		Code:      code,
+		Errors:    errors,
+		Decisions: decisions,
+	}
+
+	c.JSON(httpStatus, response)
+}
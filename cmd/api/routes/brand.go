@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"car-service/cmd/api/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupBrandRoutes(router *gin.RouterGroup, brandController controllers.BrandController) {
+	brands := router.Group("/brands")
+	{
+		brands.POST("", brandController.CreateBrand)
+		brands.GET("", brandController.GetBrands)
+		brands.GET("/:id", brandController.GetBrand)
+		brands.PUT("/:id", brandController.UpdateBrand)
+		brands.DELETE("/:id", brandController.DeleteBrand)
+		brands.POST("/:id/deactivate", brandController.DeactivateBrand)
+		brands.GET("/:id/models", brandController.GetBrandModels)
+	}
+}
@@ -11,5 +11,13 @@ func SetupCarRoutes(router *gin.RouterGroup, carController controllers.CarContro
 	{
 		cars.POST("", carController.CreateCar)
 		cars.GET("", carController.GetCars)
+		cars.GET("/:id", carController.GetCar)
+		cars.PUT("/:id", carController.UpdateCar)
+		cars.PATCH("/:id", carController.PatchCar)
+		cars.DELETE("/:id", carController.DeleteCar)
+		cars.POST("/:id/transfer", carController.TransferCarOwnership)
+		cars.GET("/:id/ownership-history", carController.GetOwnershipHistory)
+		cars.POST("/:id/status", carController.ChangeCarStatus)
+		cars.GET("/:id/status-history", carController.GetCarStatusHistory)
 	}
 }
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"car-service/cmd/api/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupInspectionRoutes(router *gin.RouterGroup, inspectionController controllers.InspectionController) {
+	inspections := router.Group("/cars/:id/inspections")
+	{
+		inspections.POST("", inspectionController.CreateInspection)
+		inspections.GET("", inspectionController.GetCarInspections)
+		inspections.GET("/:inspectionId", inspectionController.GetInspection)
+	}
+
+	router.GET("/inspections/due", inspectionController.GetDueInspections)
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"car-service/cmd/api/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupInsurancePolicyRoutes(router *gin.RouterGroup, insurancePolicyController controllers.InsurancePolicyController) {
+	policies := router.Group("/cars/:id/insurance-policies")
+	{
+		policies.POST("", insurancePolicyController.CreateInsurancePolicy)
+		policies.GET("", insurancePolicyController.GetCarInsurancePolicies)
+		policies.POST("/:policyId/terminate", insurancePolicyController.TerminateInsurancePolicy)
+	}
+
+	router.GET("/owners/:id/insurance-policies", insurancePolicyController.GetOwnerInsurancePolicies)
+	router.GET("/insurance-policies/alerts", insurancePolicyController.GetInsuranceAlerts)
+}
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"car-service/cmd/api/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupModelRoutes(router *gin.RouterGroup, modelController controllers.ModelController) {
+	models := router.Group("/models")
+	{
+		models.POST("", modelController.CreateModel)
+		models.GET("", modelController.GetModels)
+		models.GET("/:id", modelController.GetModel)
+		models.PUT("/:id", modelController.UpdateModel)
+		models.DELETE("/:id", modelController.DeleteModel)
+	}
+
+	trims := router.Group("/models/:id/trims")
+	{
+		trims.POST("", modelController.CreateModelTrim)
+		trims.GET("", modelController.GetModelTrims)
+		trims.GET("/:trimId", modelController.GetModelTrim)
+		trims.PUT("/:trimId", modelController.UpdateModelTrim)
+		trims.DELETE("/:trimId", modelController.DeleteModelTrim)
+	}
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"car-service/cmd/api/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupOdometerRoutes(router *gin.RouterGroup, odometerController controllers.OdometerController) {
+	odometer := router.Group("/cars/:id/odometer")
+	{
+		odometer.GET("", odometerController.GetOdometerHistory)
+		odometer.POST("", odometerController.CreateOdometerReading)
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"car-service/cmd/api/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupOwnerRoutes(router *gin.RouterGroup, ownerController controllers.OwnerController) {
+	owners := router.Group("/owners")
+	{
+		owners.POST("", ownerController.CreateOwner)
+		owners.GET("", ownerController.GetOwners)
+		owners.GET("/:id", ownerController.GetOwner)
+		owners.PUT("/:id", ownerController.UpdateOwner)
+		owners.DELETE("/:id", ownerController.DeleteOwner)
+		owners.GET("/:id/cars", ownerController.GetOwnerCars)
+	}
+}
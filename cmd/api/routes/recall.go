@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"car-service/cmd/api/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupRecallRoutes(router *gin.RouterGroup, recallController controllers.RecallController) {
+	recalls := router.Group("/recalls")
+	{
+		recalls.POST("", recallController.CreateRecall)
+		recalls.GET("/:id", recallController.GetRecall)
+		recalls.GET("/:id/affected-cars", recallController.GetRecallAffectedCars)
+	}
+
+	carRecalls := router.Group("/cars/:id/recalls")
+	{
+		carRecalls.GET("", recallController.GetCarRecalls)
+		carRecalls.POST("/:recallId/remedy", recallController.CompleteRecallRemedy)
+	}
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"car-service/cmd/api/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupRegistrationRoutes(router *gin.RouterGroup, registrationController controllers.RegistrationController) {
+	registrations := router.Group("/cars/:id/registrations")
+	{
+		registrations.POST("", registrationController.CreateRegistration)
+		registrations.GET("", registrationController.GetCarRegistrations)
+		registrations.POST("/:registrationId/cancel", registrationController.CancelRegistration)
+	}
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"car-service/cmd/api/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupServiceRecordRoutes(router *gin.RouterGroup, serviceRecordController controllers.ServiceRecordController) {
+	services := router.Group("/cars/:id/services")
+	{
+		services.POST("", serviceRecordController.CreateServiceRecord)
+		services.GET("", serviceRecordController.GetServiceRecords)
+		services.GET("/:serviceId", serviceRecordController.GetServiceRecord)
+		services.PUT("/:serviceId", serviceRecordController.UpdateServiceRecord)
+		services.DELETE("/:serviceId", serviceRecordController.DeleteServiceRecord)
+	}
+}
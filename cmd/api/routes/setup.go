@@ -7,10 +7,34 @@ import (
 )
 
 type Config struct {
-	CarController *controllers.CarController
+	CarController             *controllers.CarController
+	OwnerController           *controllers.OwnerController
+	BrandController           *controllers.BrandController
+	ModelController           *controllers.ModelController
+	VinController             *controllers.VinController
+	ServiceRecordController   *controllers.ServiceRecordController
+	OdometerController        *controllers.OdometerController
+	StolenReportController    *controllers.StolenReportController
+	RegistrationController    *controllers.RegistrationController
+	InspectionController      *controllers.InspectionController
+	InsurancePolicyController *controllers.InsurancePolicyController
+	RecallController          *controllers.RecallController
+	WarrantyController        *controllers.WarrantyController
 }
 
 func SetupRoutes(router *gin.Engine, config *Config) {
 	v1 := router.Group("/api/v1")
 	SetupCarRoutes(v1, *config.CarController)
+	SetupOwnerRoutes(v1, *config.OwnerController)
+	SetupBrandRoutes(v1, *config.BrandController)
+	SetupModelRoutes(v1, *config.ModelController)
+	SetupVinRoutes(v1, *config.VinController)
+	SetupServiceRecordRoutes(v1, *config.ServiceRecordController)
+	SetupOdometerRoutes(v1, *config.OdometerController)
+	SetupStolenReportRoutes(v1, *config.StolenReportController)
+	SetupRegistrationRoutes(v1, *config.RegistrationController)
+	SetupInspectionRoutes(v1, *config.InspectionController)
+	SetupInsurancePolicyRoutes(v1, *config.InsurancePolicyController)
+	SetupRecallRoutes(v1, *config.RecallController)
+	SetupWarrantyRoutes(v1, *config.WarrantyController)
 }
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"car-service/cmd/api/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupStolenReportRoutes(router *gin.RouterGroup, stolenReportController controllers.StolenReportController) {
+	reports := router.Group("/stolen-reports")
+	{
+		reports.POST("", stolenReportController.CreateStolenReport)
+		reports.GET("/:id", stolenReportController.GetStolenReport)
+		reports.POST("/:id/recover", stolenReportController.RecoverStolenReport)
+	}
+	router.GET("/cars/:id/stolen-reports", stolenReportController.GetCarStolenReports)
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"car-service/cmd/api/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupVinRoutes(router *gin.RouterGroup, vinController controllers.VinController) {
+	vin := router.Group("/vin")
+	{
+		vin.GET("/:vin/decode", vinController.DecodeVin)
+		vin.GET("/:vin/watchlist", vinController.CheckVinWatchlist)
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"car-service/cmd/api/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func SetupWarrantyRoutes(router *gin.RouterGroup, warrantyController controllers.WarrantyController) {
+	templates := router.Group("/warranty-templates")
+	{
+		templates.POST("", warrantyController.CreateWarrantyTemplate)
+		templates.GET("", warrantyController.GetWarrantyTemplates)
+		templates.GET("/:id", warrantyController.GetWarrantyTemplate)
+		templates.DELETE("/:id", warrantyController.DeleteWarrantyTemplate)
+	}
+
+	router.GET("/cars/:id/warranty", warrantyController.GetCarWarranty)
+}
@@ -21,15 +21,39 @@ type Server struct {
 }
 
 type ServerConfig struct {
-	CarController *controllers.CarController
-	Port          string
+	CarController             *controllers.CarController
+	OwnerController           *controllers.OwnerController
+	BrandController           *controllers.BrandController
+	ModelController           *controllers.ModelController
+	VinController             *controllers.VinController
+	ServiceRecordController   *controllers.ServiceRecordController
+	OdometerController        *controllers.OdometerController
+	StolenReportController    *controllers.StolenReportController
+	RegistrationController    *controllers.RegistrationController
+	InspectionController      *controllers.InspectionController
+	InsurancePolicyController *controllers.InsurancePolicyController
+	RecallController          *controllers.RecallController
+	WarrantyController        *controllers.WarrantyController
+	Port                      string
 }
 
 func NewServer(config *ServerConfig) *Server {
 	router := gin.Default()
 
 	routesConfig := &routes.Config{
-		CarController: config.CarController,
+		CarController:             config.CarController,
+		OwnerController:           config.OwnerController,
+		BrandController:           config.BrandController,
+		ModelController:           config.ModelController,
+		VinController:             config.VinController,
+		ServiceRecordController:   config.ServiceRecordController,
+		OdometerController:        config.OdometerController,
+		StolenReportController:    config.StolenReportController,
+		RegistrationController:    config.RegistrationController,
+		InspectionController:      config.InspectionController,
+		InsurancePolicyController: config.InsurancePolicyController,
+		RecallController:          config.RecallController,
+		WarrantyController:        config.WarrantyController,
 	}
 	routes.SetupRoutes(router, routesConfig)
 
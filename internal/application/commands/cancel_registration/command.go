@@ -0,0 +1,62 @@
+//internal/application/commands/cancel_registration/command.go
+
+package cancel_registration
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "CancelRegistration"
+
+type CancelRegistrationCommand struct {
+	service services.RegistrationService
+}
+
+func CreateCancelRegistrationCommand(service services.RegistrationService) *CancelRegistrationCommand {
+	return &CancelRegistrationCommand{
+		service: service,
+	}
+}
+
+func (c *CancelRegistrationCommand) Validate(request api.CommandRequest[CancelRegistrationRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if request.Data.CarID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del vehículo es requerido",
+		})
+	}
+
+	if request.Data.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "registrationId",
+			Message: "El ID de la patente es requerido",
+		})
+	}
+
+	if request.Data.CancelledAt != nil && request.Data.CancelledAt.After(time.Now()) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "cancelledAt",
+			Message: "La fecha de baja no puede ser futura",
+		})
+	}
+	return errors
+}
+
+func (c *CancelRegistrationCommand) Execute(request api.CommandRequest[CancelRegistrationRequest], ctx *context.Context) (*CancelRegistrationResponse, error) {
+	cancelledAt := time.Now()
+	if request.Data.CancelledAt != nil {
+		cancelledAt = *request.Data.CancelledAt
+	}
+
+	registration, err := c.service.CancelRegistration(*ctx, request.Data.CarID, request.Data.ID, cancelledAt)
+	if err != nil {
+		return nil, err
+	}
+	return CreateCancelRegistrationResponse(registration), nil
+}
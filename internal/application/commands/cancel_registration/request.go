@@ -0,0 +1,13 @@
+package cancel_registration
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CancelRegistrationRequest struct {
+	CarID       uuid.UUID  `json:"-" uri:"id"`
+	ID          uuid.UUID  `json:"-" uri:"registrationId"`
+	CancelledAt *time.Time `json:"cancelledat"` // Opcional; por defecto la fecha actual
+}
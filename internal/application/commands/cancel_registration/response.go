@@ -0,0 +1,22 @@
+package cancel_registration
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type CancelRegistrationResponse struct {
+	ID          string     `json:"id"`
+	Plate       string     `json:"plate"`
+	CancelledAt *time.Time `json:"cancelledAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+func CreateCancelRegistrationResponse(registration *entities.Registration) *CancelRegistrationResponse {
+	return &CancelRegistrationResponse{
+		ID:          registration.ID.String(),
+		Plate:       registration.Plate,
+		CancelledAt: registration.CancelledAt,
+		UpdatedAt:   registration.UpdatedAt,
+	}
+}
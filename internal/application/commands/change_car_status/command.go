@@ -0,0 +1,56 @@
+//internal/application/commands/change_car_status/command.go
+
+package change_car_status
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const Name = "ChangeCarStatus"
+
+type ChangeCarStatusCommand struct {
+	service services.CarService
+}
+
+func CreateChangeCarStatusCommand(service services.CarService) *ChangeCarStatusCommand {
+	return &ChangeCarStatusCommand{
+		service: service,
+	}
+}
+
+func (c *ChangeCarStatusCommand) Validate(request api.CommandRequest[ChangeCarStatusRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if request.Data.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del vehículo es requerido",
+		})
+	}
+
+	if request.Data.Status == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "status",
+			Message: "El estado es requerido",
+		})
+	} else if !entities.CarStatus(request.Data.Status).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "status",
+			Message: fmt.Sprintf("Estado no soportado: %s", request.Data.Status),
+		})
+	}
+	return errors
+}
+
+func (c *ChangeCarStatusCommand) Execute(request api.CommandRequest[ChangeCarStatusRequest], ctx *context.Context) (*ChangeCarStatusResponse, error) {
+	car, err := c.service.ChangeCarStatus(*ctx, request.Data.ID, entities.CarStatus(request.Data.Status), request.Data.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return CreateChangeCarStatusResponse(car), nil
+}
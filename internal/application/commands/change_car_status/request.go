@@ -0,0 +1,9 @@
+package change_car_status
+
+import "github.com/google/uuid"
+
+type ChangeCarStatusRequest struct {
+	ID     uuid.UUID `json:"-" uri:"id"`
+	Status string    `json:"status"`
+	Reason string    `json:"reason"`
+}
@@ -0,0 +1,22 @@
+package change_car_status
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type ChangeCarStatusResponse struct {
+	ID                 string               `json:"id"`
+	Status             entities.CarStatus   `json:"status"`
+	AllowedTransitions []entities.CarStatus `json:"allowedTransitions"`
+	UpdatedAt          time.Time            `json:"updatedAt"`
+}
+
+func CreateChangeCarStatusResponse(car *entities.Car) *ChangeCarStatusResponse {
+	return &ChangeCarStatusResponse{
+		ID:                 car.ID.String(),
+		Status:             car.Status,
+		AllowedTransitions: car.Status.AllowedTransitions(),
+		UpdatedAt:          car.UpdatedAt,
+	}
+}
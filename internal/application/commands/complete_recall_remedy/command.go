@@ -0,0 +1,76 @@
+//internal/application/commands/complete_recall_remedy/command.go
+
+package complete_recall_remedy
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "CompleteRecallRemedy"
+
+type CompleteRecallRemedyCommand struct {
+	service services.RecallService
+}
+
+func CreateCompleteRecallRemedyCommand(service services.RecallService) *CompleteRecallRemedyCommand {
+	return &CompleteRecallRemedyCommand{
+		service: service,
+	}
+}
+
+func (c *CompleteRecallRemedyCommand) Validate(request api.CommandRequest[CompleteRecallRemedyRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if request.Data.CarID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del vehículo es requerido",
+		})
+	}
+
+	if request.Data.RecallID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "recallId",
+			Message: "El ID de la campaña es requerido",
+		})
+	}
+
+	if strings.TrimSpace(request.Data.Workshop) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "workshop",
+			Message: "El taller es requerido",
+		})
+	}
+
+	if request.Data.RemediedAt != nil && request.Data.RemediedAt.After(time.Now()) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "remediedAt",
+			Message: "La fecha de reparación no puede ser futura",
+		})
+	}
+	return errors
+}
+
+func (c *CompleteRecallRemedyCommand) Execute(request api.CommandRequest[CompleteRecallRemedyRequest], ctx *context.Context) (*CompleteRecallRemedyResponse, error) {
+	remediedAt := time.Now()
+	if request.Data.RemediedAt != nil {
+		remediedAt = *request.Data.RemediedAt
+	}
+
+	carRecall, err := c.service.CompleteRemedy(*ctx, services.RecallRemedy{
+		CarID:    request.Data.CarID,
+		RecallID: request.Data.RecallID,
+		Date:     remediedAt,
+		Workshop: strings.TrimSpace(request.Data.Workshop),
+		Notes:    request.Data.Notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return CreateCompleteRecallRemedyResponse(carRecall), nil
+}
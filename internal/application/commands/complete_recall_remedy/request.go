@@ -0,0 +1,15 @@
+package complete_recall_remedy
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CompleteRecallRemedyRequest struct {
+	CarID      uuid.UUID  `json:"-" uri:"id"`
+	RecallID   uuid.UUID  `json:"-" uri:"recallId"`
+	RemediedAt *time.Time `json:"remediedat"` // Opcional; por defecto la fecha actual
+	Workshop   string     `json:"workshop"`
+	Notes      string     `json:"notes"`
+}
@@ -0,0 +1,30 @@
+package complete_recall_remedy
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type CompleteRecallRemedyResponse struct {
+	CarID      string                      `json:"carId"`
+	RecallID   string                      `json:"recallId"`
+	Campaign   string                      `json:"campaign"`
+	Status     entities.RecallRemedyStatus `json:"status"`
+	RemediedAt *time.Time                  `json:"remediedAt"`
+	Workshop   string                      `json:"workshop"`
+	Notes      string                      `json:"notes"`
+	UpdatedAt  time.Time                   `json:"updatedAt"`
+}
+
+func CreateCompleteRecallRemedyResponse(carRecall *entities.CarRecall) *CompleteRecallRemedyResponse {
+	return &CompleteRecallRemedyResponse{
+		CarID:      carRecall.CarID.String(),
+		RecallID:   carRecall.RecallID.String(),
+		Campaign:   carRecall.Recall.Campaign,
+		Status:     carRecall.RemedyStatus(),
+		RemediedAt: carRecall.RemediedAt,
+		Workshop:   carRecall.Workshop,
+		Notes:      carRecall.Notes,
+		UpdatedAt:  carRecall.UpdatedAt,
+	}
+}
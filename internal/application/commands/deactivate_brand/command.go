@@ -0,0 +1,46 @@
+//internal/application/commands/deactivate_brand/command.go
+
+package deactivate_brand
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "DeactivateBrand"
+
+type DeactivateBrandCommand struct {
+	service services.BrandService
+}
+
+func CreateDeactivateBrandCommand(service services.BrandService) *DeactivateBrandCommand {
+	return &DeactivateBrandCommand{
+		service: service,
+	}
+}
+
+func (c *DeactivateBrandCommand) Validate(request api.CommandRequest[DeactivateBrandRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if request.Data.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID de la marca es requerido",
+		})
+	}
+	return errors
+}
+
+func (c *DeactivateBrandCommand) Execute(request api.CommandRequest[DeactivateBrandRequest], ctx *context.Context) (*DeactivateBrandResponse, error) {
+	brand, err := c.service.DeactivateBrand(*ctx, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &DeactivateBrandResponse{
+		ID:        brand.ID.String(),
+		Active:    brand.Active,
+		UpdatedAt: brand.UpdatedAt,
+	}, nil
+}
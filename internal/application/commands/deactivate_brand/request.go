@@ -0,0 +1,7 @@
+package deactivate_brand
+
+import "github.com/google/uuid"
+
+type DeactivateBrandRequest struct {
+	ID uuid.UUID `json:"-" uri:"id"`
+}
@@ -0,0 +1,9 @@
+package deactivate_brand
+
+import "time"
+
+type DeactivateBrandResponse struct {
+	ID        string    `json:"id"`
+	Active    bool      `json:"active"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
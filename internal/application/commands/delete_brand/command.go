@@ -0,0 +1,41 @@
+//internal/application/commands/delete_brand/command.go
+
+package delete_brand
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "DeleteBrand"
+
+type DeleteBrandCommand struct {
+	service services.BrandService
+}
+
+func CreateDeleteBrandCommand(service services.BrandService) *DeleteBrandCommand {
+	return &DeleteBrandCommand{
+		service: service,
+	}
+}
+
+func (c *DeleteBrandCommand) Validate(request api.CommandRequest[DeleteBrandRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if request.Data.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID de la marca es requerido",
+		})
+	}
+	return errors
+}
+
+func (c *DeleteBrandCommand) Execute(request api.CommandRequest[DeleteBrandRequest], ctx *context.Context) (*DeleteBrandResponse, error) {
+	if err := c.service.DeleteBrand(*ctx, request.Data.ID); err != nil {
+		return nil, err
+	}
+	return &DeleteBrandResponse{ID: request.Data.ID.String()}, nil
+}
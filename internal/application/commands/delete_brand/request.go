@@ -0,0 +1,7 @@
+package delete_brand
+
+import "github.com/google/uuid"
+
+type DeleteBrandRequest struct {
+	ID uuid.UUID `json:"-" uri:"id"`
+}
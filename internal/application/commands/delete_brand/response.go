@@ -0,0 +1,5 @@
+package delete_brand
+
+type DeleteBrandResponse struct {
+	ID string `json:"id"`
+}
@@ -0,0 +1,41 @@
+//internal/application/commands/delete_car/command.go
+
+package delete_car
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "DeleteCar"
+
+type DeleteCarCommand struct {
+	service services.CarService
+}
+
+func CreateDeleteCarCommand(service services.CarService) *DeleteCarCommand {
+	return &DeleteCarCommand{
+		service: service,
+	}
+}
+
+func (c *DeleteCarCommand) Validate(request api.CommandRequest[DeleteCarRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if request.Data.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del vehículo es requerido",
+		})
+	}
+	return errors
+}
+
+func (c *DeleteCarCommand) Execute(request api.CommandRequest[DeleteCarRequest], ctx *context.Context) (*DeleteCarResponse, error) {
+	if err := c.service.DeleteCar(*ctx, request.Data.ID); err != nil {
+		return nil, err
+	}
+	return &DeleteCarResponse{ID: request.Data.ID.String()}, nil
+}
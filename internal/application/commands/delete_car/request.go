@@ -0,0 +1,7 @@
+package delete_car
+
+import "github.com/google/uuid"
+
+type DeleteCarRequest struct {
+	ID uuid.UUID `json:"-" uri:"id"`
+}
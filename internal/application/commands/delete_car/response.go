@@ -0,0 +1,5 @@
+package delete_car
+
+type DeleteCarResponse struct {
+	ID string `json:"id"`
+}
@@ -0,0 +1,41 @@
+//internal/application/commands/delete_model/command.go
+
+package delete_model
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "DeleteModel"
+
+type DeleteModelCommand struct {
+	service services.ModelService
+}
+
+func CreateDeleteModelCommand(service services.ModelService) *DeleteModelCommand {
+	return &DeleteModelCommand{
+		service: service,
+	}
+}
+
+func (c *DeleteModelCommand) Validate(request api.CommandRequest[DeleteModelRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if request.Data.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del modelo es requerido",
+		})
+	}
+	return errors
+}
+
+func (c *DeleteModelCommand) Execute(request api.CommandRequest[DeleteModelRequest], ctx *context.Context) (*DeleteModelResponse, error) {
+	if err := c.service.DeleteModel(*ctx, request.Data.ID); err != nil {
+		return nil, err
+	}
+	return &DeleteModelResponse{ID: request.Data.ID.String()}, nil
+}
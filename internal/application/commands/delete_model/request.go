@@ -0,0 +1,7 @@
+package delete_model
+
+import "github.com/google/uuid"
+
+type DeleteModelRequest struct {
+	ID uuid.UUID `json:"-" uri:"id"`
+}
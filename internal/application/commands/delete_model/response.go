@@ -0,0 +1,5 @@
+package delete_model
+
+type DeleteModelResponse struct {
+	ID string `json:"id"`
+}
@@ -0,0 +1,48 @@
+//internal/application/commands/delete_model_trim/command.go
+
+package delete_model_trim
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "DeleteModelTrim"
+
+type DeleteModelTrimCommand struct {
+	service services.ModelService
+}
+
+func CreateDeleteModelTrimCommand(service services.ModelService) *DeleteModelTrimCommand {
+	return &DeleteModelTrimCommand{
+		service: service,
+	}
+}
+
+func (c *DeleteModelTrimCommand) Validate(request api.CommandRequest[DeleteModelTrimRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if request.Data.ModelID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del modelo es requerido",
+		})
+	}
+
+	if request.Data.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "trimId",
+			Message: "El ID de la versión es requerido",
+		})
+	}
+	return errors
+}
+
+func (c *DeleteModelTrimCommand) Execute(request api.CommandRequest[DeleteModelTrimRequest], ctx *context.Context) (*DeleteModelTrimResponse, error) {
+	if err := c.service.DeleteTrim(*ctx, request.Data.ModelID, request.Data.ID); err != nil {
+		return nil, err
+	}
+	return &DeleteModelTrimResponse{ID: request.Data.ID.String()}, nil
+}
@@ -0,0 +1,8 @@
+package delete_model_trim
+
+import "github.com/google/uuid"
+
+type DeleteModelTrimRequest struct {
+	ModelID uuid.UUID `json:"-" uri:"id"`
+	ID      uuid.UUID `json:"-" uri:"trimId"`
+}
@@ -0,0 +1,5 @@
+package delete_model_trim
+
+type DeleteModelTrimResponse struct {
+	ID string `json:"id"`
+}
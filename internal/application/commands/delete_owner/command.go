@@ -0,0 +1,41 @@
+//internal/application/commands/delete_owner/command.go
+
+package delete_owner
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "DeleteOwner"
+
+type DeleteOwnerCommand struct {
+	service services.OwnerService
+}
+
+func CreateDeleteOwnerCommand(service services.OwnerService) *DeleteOwnerCommand {
+	return &DeleteOwnerCommand{
+		service: service,
+	}
+}
+
+func (c *DeleteOwnerCommand) Validate(request api.CommandRequest[DeleteOwnerRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if request.Data.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del propietario es requerido",
+		})
+	}
+	return errors
+}
+
+func (c *DeleteOwnerCommand) Execute(request api.CommandRequest[DeleteOwnerRequest], ctx *context.Context) (*DeleteOwnerResponse, error) {
+	if err := c.service.DeleteOwner(*ctx, request.Data.ID); err != nil {
+		return nil, err
+	}
+	return &DeleteOwnerResponse{ID: request.Data.ID.String()}, nil
+}
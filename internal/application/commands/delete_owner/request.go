@@ -0,0 +1,7 @@
+package delete_owner
+
+import "github.com/google/uuid"
+
+type DeleteOwnerRequest struct {
+	ID uuid.UUID `json:"-" uri:"id"`
+}
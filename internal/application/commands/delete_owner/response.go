@@ -0,0 +1,5 @@
+package delete_owner
+
+type DeleteOwnerResponse struct {
+	ID string `json:"id"`
+}
@@ -0,0 +1,48 @@
+//internal/application/commands/delete_service_record/command.go
+
+package delete_service_record
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "DeleteServiceRecord"
+
+type DeleteServiceRecordCommand struct {
+	service services.ServiceRecordService
+}
+
+func CreateDeleteServiceRecordCommand(service services.ServiceRecordService) *DeleteServiceRecordCommand {
+	return &DeleteServiceRecordCommand{
+		service: service,
+	}
+}
+
+func (c *DeleteServiceRecordCommand) Validate(request api.CommandRequest[DeleteServiceRecordRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if request.Data.CarID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del vehículo es requerido",
+		})
+	}
+
+	if request.Data.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "serviceId",
+			Message: "El ID del servicio es requerido",
+		})
+	}
+	return errors
+}
+
+func (c *DeleteServiceRecordCommand) Execute(request api.CommandRequest[DeleteServiceRecordRequest], ctx *context.Context) (*DeleteServiceRecordResponse, error) {
+	if err := c.service.DeleteServiceRecord(*ctx, request.Data.CarID, request.Data.ID); err != nil {
+		return nil, err
+	}
+	return &DeleteServiceRecordResponse{ID: request.Data.ID.String()}, nil
+}
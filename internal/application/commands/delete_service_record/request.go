@@ -0,0 +1,8 @@
+package delete_service_record
+
+import "github.com/google/uuid"
+
+type DeleteServiceRecordRequest struct {
+	CarID uuid.UUID `json:"-" uri:"id"`
+	ID    uuid.UUID `json:"-" uri:"serviceId"`
+}
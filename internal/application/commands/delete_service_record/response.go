@@ -0,0 +1,5 @@
+package delete_service_record
+
+type DeleteServiceRecordResponse struct {
+	ID string `json:"id"`
+}
@@ -0,0 +1,41 @@
+//internal/application/commands/delete_warranty_template/command.go
+
+package delete_warranty_template
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "DeleteWarrantyTemplate"
+
+type DeleteWarrantyTemplateCommand struct {
+	service services.WarrantyService
+}
+
+func CreateDeleteWarrantyTemplateCommand(service services.WarrantyService) *DeleteWarrantyTemplateCommand {
+	return &DeleteWarrantyTemplateCommand{
+		service: service,
+	}
+}
+
+func (c *DeleteWarrantyTemplateCommand) Validate(request api.CommandRequest[DeleteWarrantyTemplateRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if request.Data.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID de la plantilla es requerido",
+		})
+	}
+	return errors
+}
+
+func (c *DeleteWarrantyTemplateCommand) Execute(request api.CommandRequest[DeleteWarrantyTemplateRequest], ctx *context.Context) (*DeleteWarrantyTemplateResponse, error) {
+	if err := c.service.DeleteTemplate(*ctx, request.Data.ID); err != nil {
+		return nil, err
+	}
+	return &DeleteWarrantyTemplateResponse{ID: request.Data.ID.String()}, nil
+}
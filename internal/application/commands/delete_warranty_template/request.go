@@ -0,0 +1,7 @@
+package delete_warranty_template
+
+import "github.com/google/uuid"
+
+type DeleteWarrantyTemplateRequest struct {
+	ID uuid.UUID `json:"-" uri:"id"`
+}
@@ -0,0 +1,5 @@
+package delete_warranty_template
+
+type DeleteWarrantyTemplateResponse struct {
+	ID string `json:"id"`
+}
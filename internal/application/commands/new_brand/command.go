@@ -0,0 +1,44 @@
+//internal/application/commands/new_brand/command.go
+
+package new_brand
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"context"
+	"strings"
+)
+
+const Name = "CreateBrand"
+
+type NewBrandCommand struct {
+	service services.BrandService
+}
+
+func CreateNewBrandCommand(service services.BrandService) *NewBrandCommand {
+	return &NewBrandCommand{
+		service: service,
+	}
+}
+
+func (c *NewBrandCommand) Validate(request api.CommandRequest[NewBrandRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if strings.TrimSpace(request.Data.Name) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "name",
+			Message: "El nombre de la marca es requerido",
+		})
+	}
+	return errors
+}
+
+func (c *NewBrandCommand) Execute(request api.CommandRequest[NewBrandRequest], ctx *context.Context) (*NewBrandResponse, error) {
+	brandRequest := request.Data
+	brand := entities.NewBrand(strings.TrimSpace(brandRequest.Name), brandRequest.Country, brandRequest.LogoURL)
+	brandResult, err := c.service.CreateBrand(*ctx, brand)
+	if err != nil {
+		return nil, err
+	}
+	return CreateBrandResponse(brandResult), nil
+}
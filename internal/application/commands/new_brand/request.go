@@ -0,0 +1,7 @@
+package new_brand
+
+type NewBrandRequest struct {
+	Name    string `json:"name"`
+	Country string `json:"country"`
+	LogoURL string `json:"logourl"`
+}
@@ -0,0 +1,26 @@
+package new_brand
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type NewBrandResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Country   string    `json:"country"`
+	LogoURL   string    `json:"logoUrl"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func CreateBrandResponse(brand *entities.Brand) *NewBrandResponse {
+	return &NewBrandResponse{
+		ID:        brand.ID.String(),
+		Name:      brand.Name,
+		Country:   brand.Country,
+		LogoURL:   brand.LogoURL,
+		Active:    brand.Active,
+		CreatedAt: brand.CreatedAt,
+	}
+}
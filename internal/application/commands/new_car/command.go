@@ -6,6 +6,7 @@ import (
 	api "car-service/cmd/api/mediator"
 	"car-service/internal/domain/entities"
 	"car-service/internal/domain/services"
+	"car-service/internal/domain/vin"
 	"context"
 	"time"
 
@@ -24,9 +25,9 @@ func CreateNewCarCommand(service services.CarService) *NewCarCommand {
 	}
 }
 
-func (c *NewCarCommand) Validate(request api.CommandRequest[any], commandContext *api.CommandContext) []*api.ValidationError {
+func (c *NewCarCommand) Validate(request api.CommandRequest[NewCarRequest], commandContext *api.CommandContext) []*api.ValidationError {
 	var errors []*api.ValidationError
-	carRequest := request.Data.(*NewCarRequest)
+	carRequest := request.Data
 	if carRequest.ModelId == uuid.Nil {
 		errors = append(errors, &api.ValidationError{
 			Field:   "modelId",
@@ -34,6 +35,13 @@ func (c *NewCarCommand) Validate(request api.CommandRequest[any], commandContext
 		})
 	}
 
+	if carRequest.TrimId != nil && *carRequest.TrimId == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "trimId",
+			Message: "El ID de la versión no puede estar vacío",
+		})
+	}
+
 	if carRequest.OwnerId == uuid.Nil {
 		errors = append(errors, &api.ValidationError{
 			Field:   "ownerId",
@@ -46,10 +54,10 @@ func (c *NewCarCommand) Validate(request api.CommandRequest[any], commandContext
 			Field:   "vin",
 			Message: "El VIN es requerido",
 		})
-	} else if len(carRequest.Vin) != 17 {
+	} else if err := vin.Validate(carRequest.Vin); err != nil {
 		errors = append(errors, &api.ValidationError{
 			Field:   "vin",
-			Message: "El VIN debe tener 17 caracteres",
+			Message: err.Error(),
 		})
 	}
 
@@ -62,14 +70,15 @@ func (c *NewCarCommand) Validate(request api.CommandRequest[any], commandContext
 	return errors
 }
 
-func (c *NewCarCommand) Execute(request api.CommandRequest[any], ctx *context.Context) (any, error) {
-	carRequest := request.Data.(*NewCarRequest)
+func (c *NewCarCommand) Execute(request api.CommandRequest[NewCarRequest], ctx *context.Context) (*NewCarResponse, error) {
+	carRequest := request.Data
 	car := entities.Car{
 		ModelID: carRequest.ModelId,
+		TrimID:  carRequest.TrimId,
 		OwnerID: carRequest.OwnerId,
 		Year:    carRequest.Year,
 		Color:   carRequest.Color,
-		VIN:     carRequest.Vin,
+		VIN:     vin.Normalize(carRequest.Vin),
 	}
 	carResult, err := c.service.CreateCar(*ctx, &car)
 	if err != nil {
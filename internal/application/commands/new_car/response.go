@@ -8,6 +8,7 @@ import (
 type NewCarResponse struct {
 	ID        string    `json:"id"`
 	ModelID   string    `json:"modelId"`
+	TrimID    *string   `json:"trimId"`
 	OwnerID   string    `json:"ownerId"`
 	Year      int       `json:"year"`
 	Color     string    `json:"color"`
@@ -16,7 +17,7 @@ type NewCarResponse struct {
 }
 
 func CreateCarResponse(car *entities.Car) *NewCarResponse {
-	return &NewCarResponse{
+	response := &NewCarResponse{
 		ID:        car.ID.String(),
 		ModelID:   car.ModelID.String(),
 		OwnerID:   car.OwnerID.String(),
@@ -25,4 +26,9 @@ func CreateCarResponse(car *entities.Car) *NewCarResponse {
 		VIN:       car.VIN,
 		CreatedAt: car.CreatedAt,
 	}
+	if car.TrimID != nil {
+		trimID := car.TrimID.String()
+		response.TrimID = &trimID
+	}
+	return response
 }
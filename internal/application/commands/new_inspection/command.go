@@ -0,0 +1,116 @@
+//internal/application/commands/new_inspection/command.go
+
+package new_inspection
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "CreateInspection"
+
+type NewInspectionCommand struct {
+	service services.InspectionService
+}
+
+func CreateNewInspectionCommand(service services.InspectionService) *NewInspectionCommand {
+	return &NewInspectionCommand{
+		service: service,
+	}
+}
+
+func (c *NewInspectionCommand) Validate(request api.CommandRequest[NewInspectionRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	inspectionRequest := request.Data
+	if inspectionRequest.CarID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del vehículo es requerido",
+		})
+	}
+
+	if inspectionRequest.Date != nil && inspectionRequest.Date.After(time.Now()) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "date",
+			Message: "La fecha de la inspección no puede ser futura",
+		})
+	}
+
+	if strings.TrimSpace(inspectionRequest.Station) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "station",
+			Message: "La planta verificadora es requerida",
+		})
+	}
+
+	result := entities.InspectionResult(inspectionRequest.Result)
+	if !result.IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "result",
+			Message: fmt.Sprintf("Resultado de inspección no soportado: %s", inspectionRequest.Result),
+		})
+	} else if result != entities.InspectionResultApproved && len(inspectionRequest.Defects) == 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "defects",
+			Message: "Una inspección condicional o rechazada debe informar al menos un defecto",
+		})
+	}
+
+	if inspectionRequest.Odometer != nil && *inspectionRequest.Odometer < 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "odometer",
+			Message: "El kilometraje no puede ser negativo",
+		})
+	}
+
+	for i, defect := range inspectionRequest.Defects {
+		if strings.TrimSpace(defect.Description) == "" {
+			errors = append(errors, &api.ValidationError{
+				Field:   fmt.Sprintf("defects[%d].description", i),
+				Message: "La descripción del defecto es requerida",
+			})
+		}
+		if !entities.DefectSeverity(defect.Severity).IsValid() {
+			errors = append(errors, &api.ValidationError{
+				Field:   fmt.Sprintf("defects[%d].severity", i),
+				Message: fmt.Sprintf("Gravedad de defecto no soportada: %s", defect.Severity),
+			})
+		}
+	}
+	return errors
+}
+
+func (c *NewInspectionCommand) Execute(request api.CommandRequest[NewInspectionRequest], ctx *context.Context) (*NewInspectionResponse, error) {
+	inspectionRequest := request.Data
+	date := time.Now()
+	if inspectionRequest.Date != nil {
+		date = *inspectionRequest.Date
+	}
+
+	defects := make([]entities.InspectionDefect, len(inspectionRequest.Defects))
+	for i, defect := range inspectionRequest.Defects {
+		defects[i] = entities.NewInspectionDefect(strings.TrimSpace(defect.Description), entities.DefectSeverity(defect.Severity))
+	}
+
+	inspection := entities.NewInspection(
+		inspectionRequest.CarID,
+		date,
+		strings.TrimSpace(inspectionRequest.Station),
+		entities.InspectionResult(inspectionRequest.Result),
+		defects,
+		inspectionRequest.Odometer,
+		inspectionRequest.Notes,
+	)
+	inspectionResult, err := c.service.CreateInspection(*ctx, inspection)
+	if err != nil {
+		return nil, err
+	}
+	return CreateNewInspectionResponse(inspectionResult), nil
+}
@@ -0,0 +1,22 @@
+package new_inspection
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NewInspectionRequest struct {
+	CarID    uuid.UUID                 `json:"-" uri:"id"`
+	Date     *time.Time                `json:"date"` // Opcional; por defecto la fecha actual
+	Station  string                    `json:"station"`
+	Result   string                    `json:"result"`
+	Defects  []InspectionDefectRequest `json:"defects"`
+	Odometer *int                      `json:"odometer"`
+	Notes    string                    `json:"notes"`
+}
+
+type InspectionDefectRequest struct {
+	Description string `json:"description"`
+	Severity    string `json:"severity"`
+}
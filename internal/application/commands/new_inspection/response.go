@@ -0,0 +1,35 @@
+package new_inspection
+
+import (
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type NewInspectionResponse struct {
+	ID          string                          `json:"id"`
+	CarID       string                          `json:"carId"`
+	Date        time.Time                       `json:"date"`
+	Station     string                          `json:"station"`
+	Result      entities.InspectionResult       `json:"result"`
+	Defects     []*dto.InspectionDefectResponse `json:"defects"`
+	Odometer    *int                            `json:"odometer"`
+	NextDueDate time.Time                       `json:"nextDueDate"`
+	Notes       string                          `json:"notes"`
+	CreatedAt   time.Time                       `json:"createdAt"`
+}
+
+func CreateNewInspectionResponse(inspection *entities.Inspection) *NewInspectionResponse {
+	return &NewInspectionResponse{
+		ID:          inspection.ID.String(),
+		CarID:       inspection.CarID.String(),
+		Date:        inspection.Date,
+		Station:     inspection.Station,
+		Result:      inspection.Result,
+		Defects:     dto.CreateInspectionDefectResponses(inspection.Defects),
+		Odometer:    inspection.Odometer,
+		NextDueDate: inspection.NextDueDate,
+		Notes:       inspection.Notes,
+		CreatedAt:   inspection.CreatedAt,
+	}
+}
@@ -0,0 +1,105 @@
+//internal/application/commands/new_insurance_policy/command.go
+
+package new_insurance_policy
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const Name = "CreateInsurancePolicy"
+
+type NewInsurancePolicyCommand struct {
+	service services.InsurancePolicyService
+}
+
+func CreateNewInsurancePolicyCommand(service services.InsurancePolicyService) *NewInsurancePolicyCommand {
+	return &NewInsurancePolicyCommand{
+		service: service,
+	}
+}
+
+func (c *NewInsurancePolicyCommand) Validate(request api.CommandRequest[NewInsurancePolicyRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	policyRequest := request.Data
+	if policyRequest.CarID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del vehículo es requerido",
+		})
+	}
+
+	if strings.TrimSpace(policyRequest.Insurer) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "insurer",
+			Message: "La aseguradora es requerida",
+		})
+	}
+
+	if strings.TrimSpace(policyRequest.PolicyNumber) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "policyNumber",
+			Message: "El número de póliza es requerido",
+		})
+	}
+
+	if !entities.CoverageType(policyRequest.CoverageType).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "coverageType",
+			Message: fmt.Sprintf("Tipo de cobertura no soportado: %s", policyRequest.CoverageType),
+		})
+	}
+
+	if policyRequest.StartDate.IsZero() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "startDate",
+			Message: "La fecha de inicio es requerida",
+		})
+	}
+
+	if policyRequest.EndDate.IsZero() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "endDate",
+			Message: "La fecha de vencimiento es requerida",
+		})
+	} else if !policyRequest.EndDate.After(policyRequest.StartDate) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "endDate",
+			Message: "La fecha de vencimiento debe ser posterior a la fecha de inicio",
+		})
+	}
+
+	if policyRequest.Premium < 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "premium",
+			Message: "La prima no puede ser negativa",
+		})
+	}
+	return errors
+}
+
+func (c *NewInsurancePolicyCommand) Execute(request api.CommandRequest[NewInsurancePolicyRequest], ctx *context.Context) (*NewInsurancePolicyResponse, error) {
+	policyRequest := request.Data
+	policy := entities.NewInsurancePolicy(
+		policyRequest.CarID,
+		policyRequest.OwnerID,
+		strings.TrimSpace(policyRequest.Insurer),
+		strings.TrimSpace(policyRequest.PolicyNumber),
+		entities.CoverageType(policyRequest.CoverageType),
+		policyRequest.StartDate,
+		policyRequest.EndDate,
+		policyRequest.Premium,
+		policyRequest.Notes,
+	)
+	policyResult, err := c.service.CreatePolicy(*ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	return CreateNewInsurancePolicyResponse(policyResult), nil
+}
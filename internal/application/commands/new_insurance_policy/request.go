@@ -0,0 +1,19 @@
+package new_insurance_policy
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NewInsurancePolicyRequest struct {
+	CarID        uuid.UUID `json:"-" uri:"id"`
+	OwnerID      uuid.UUID `json:"ownerid"` // Opcional; por defecto el propietario actual del vehículo
+	Insurer      string    `json:"insurer"`
+	PolicyNumber string    `json:"policynumber"`
+	CoverageType string    `json:"coveragetype"`
+	StartDate    time.Time `json:"startdate"`
+	EndDate      time.Time `json:"enddate"`
+	Premium      float64   `json:"premium"`
+	Notes        string    `json:"notes"`
+}
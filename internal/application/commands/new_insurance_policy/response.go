@@ -0,0 +1,36 @@
+package new_insurance_policy
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type NewInsurancePolicyResponse struct {
+	ID           string                `json:"id"`
+	CarID        string                `json:"carId"`
+	OwnerID      string                `json:"ownerId"`
+	Insurer      string                `json:"insurer"`
+	PolicyNumber string                `json:"policyNumber"`
+	CoverageType entities.CoverageType `json:"coverageType"`
+	StartDate    time.Time             `json:"startDate"`
+	EndDate      time.Time             `json:"endDate"`
+	Premium      float64               `json:"premium"`
+	Notes        string                `json:"notes"`
+	CreatedAt    time.Time             `json:"createdAt"`
+}
+
+func CreateNewInsurancePolicyResponse(policy *entities.InsurancePolicy) *NewInsurancePolicyResponse {
+	return &NewInsurancePolicyResponse{
+		ID:           policy.ID.String(),
+		CarID:        policy.CarID.String(),
+		OwnerID:      policy.OwnerID.String(),
+		Insurer:      policy.Insurer,
+		PolicyNumber: policy.PolicyNumber,
+		CoverageType: policy.CoverageType,
+		StartDate:    policy.StartDate,
+		EndDate:      policy.EndDate,
+		Premium:      policy.Premium,
+		Notes:        policy.Notes,
+		CreatedAt:    policy.CreatedAt,
+	}
+}
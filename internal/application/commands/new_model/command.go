@@ -0,0 +1,70 @@
+//internal/application/commands/new_model/command.go
+
+package new_model
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "CreateModel"
+
+type NewModelCommand struct {
+	service services.ModelService
+}
+
+func CreateNewModelCommand(service services.ModelService) *NewModelCommand {
+	return &NewModelCommand{
+		service: service,
+	}
+}
+
+func (c *NewModelCommand) Validate(request api.CommandRequest[NewModelRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	modelRequest := request.Data
+	if strings.TrimSpace(modelRequest.Name) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "name",
+			Message: "El nombre del modelo es requerido",
+		})
+	}
+
+	if modelRequest.BrandId == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "brandId",
+			Message: "El ID de la marca es requerido",
+		})
+	}
+
+	if modelRequest.StartYear < 1900 || modelRequest.StartYear > time.Now().Year()+1 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "startYear",
+			Message: "El año de inicio debe estar entre 1900 y el año siguiente al actual",
+		})
+	}
+
+	if modelRequest.EndYear != 0 && modelRequest.EndYear < modelRequest.StartYear {
+		errors = append(errors, &api.ValidationError{
+			Field:   "endYear",
+			Message: "El año de fin debe ser 0 o mayor o igual al año de inicio",
+		})
+	}
+	return errors
+}
+
+func (c *NewModelCommand) Execute(request api.CommandRequest[NewModelRequest], ctx *context.Context) (*NewModelResponse, error) {
+	modelRequest := request.Data
+	model := entities.NewModel(strings.TrimSpace(modelRequest.Name), modelRequest.BrandId, modelRequest.StartYear, modelRequest.Category)
+	model.EndYear = modelRequest.EndYear
+	modelResult, err := c.service.CreateModel(*ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	return CreateModelResponse(modelResult), nil
+}
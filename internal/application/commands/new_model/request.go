@@ -0,0 +1,11 @@
+package new_model
+
+import "github.com/google/uuid"
+
+type NewModelRequest struct {
+	Name      string    `json:"name"`
+	BrandId   uuid.UUID `json:"brandid"`
+	StartYear int       `json:"startyear"`
+	EndYear   int       `json:"endyear"` // 0 si el modelo sigue en producción
+	Category  string    `json:"category"`
+}
@@ -0,0 +1,146 @@
+//internal/application/commands/new_model_trim/command.go
+
+package new_model_trim
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const Name = "CreateModelTrim"
+
+type NewModelTrimCommand struct {
+	service services.ModelService
+}
+
+func CreateNewModelTrimCommand(service services.ModelService) *NewModelTrimCommand {
+	return &NewModelTrimCommand{
+		service: service,
+	}
+}
+
+func (c *NewModelTrimCommand) Validate(request api.CommandRequest[NewModelTrimRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	trimRequest := request.Data
+	if trimRequest.ModelID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del modelo es requerido",
+		})
+	}
+
+	if strings.TrimSpace(trimRequest.Name) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "name",
+			Message: "El nombre de la versión es requerido",
+		})
+	}
+
+	if !entities.FuelType(trimRequest.FuelType).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "fuelType",
+			Message: fmt.Sprintf("Combustible no soportado: %s", trimRequest.FuelType),
+		})
+	}
+
+	if trimRequest.EngineDisplacement < 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "engineDisplacement",
+			Message: "La cilindrada no puede ser negativa",
+		})
+	} else if trimRequest.EngineDisplacement == 0 && entities.FuelType(trimRequest.FuelType) != entities.FuelTypeElectric {
+		errors = append(errors, &api.ValidationError{
+			Field:   "engineDisplacement",
+			Message: "La cilindrada es requerida salvo para las versiones eléctricas",
+		})
+	} else if trimRequest.EngineDisplacement > 0 && entities.FuelType(trimRequest.FuelType) == entities.FuelTypeElectric {
+		errors = append(errors, &api.ValidationError{
+			Field:   "engineDisplacement",
+			Message: "Una versión eléctrica no tiene cilindrada",
+		})
+	}
+
+	if trimRequest.Power <= 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "power",
+			Message: "La potencia debe ser mayor a 0",
+		})
+	}
+
+	if !entities.Transmission(trimRequest.Transmission).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "transmission",
+			Message: fmt.Sprintf("Tipo de caja no soportado: %s", trimRequest.Transmission),
+		})
+	}
+
+	if !entities.Drivetrain(trimRequest.Drivetrain).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "drivetrain",
+			Message: fmt.Sprintf("Tipo de tracción no soportado: %s", trimRequest.Drivetrain),
+		})
+	}
+
+	if trimRequest.Doors < 2 || trimRequest.Doors > 5 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "doors",
+			Message: "La cantidad de puertas debe estar entre 2 y 5",
+		})
+	}
+
+	if trimRequest.Seats < 1 || trimRequest.Seats > 9 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "seats",
+			Message: "La cantidad de plazas debe estar entre 1 y 9",
+		})
+	}
+
+	if trimRequest.CurbWeight < 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "curbWeight",
+			Message: "El peso no puede ser negativo",
+		})
+	}
+
+	if trimRequest.CO2Emissions < 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "co2Emissions",
+			Message: "Las emisiones de CO2 no pueden ser negativas",
+		})
+	} else if trimRequest.CO2Emissions > 0 && entities.FuelType(trimRequest.FuelType) == entities.FuelTypeElectric {
+		errors = append(errors, &api.ValidationError{
+			Field:   "co2Emissions",
+			Message: "Una versión eléctrica no tiene emisiones de CO2",
+		})
+	}
+	return errors
+}
+
+func (c *NewModelTrimCommand) Execute(request api.CommandRequest[NewModelTrimRequest], ctx *context.Context) (*NewModelTrimResponse, error) {
+	trimRequest := request.Data
+	trim := entities.NewModelTrim(
+		trimRequest.ModelID,
+		strings.TrimSpace(trimRequest.Name),
+		trimRequest.EngineDisplacement,
+		trimRequest.Power,
+		entities.FuelType(trimRequest.FuelType),
+		entities.Transmission(trimRequest.Transmission),
+		entities.Drivetrain(trimRequest.Drivetrain),
+		trimRequest.Doors,
+		trimRequest.Seats,
+		trimRequest.CurbWeight,
+		trimRequest.CO2Emissions,
+	)
+
+	trimResult, err := c.service.CreateTrim(*ctx, trim)
+	if err != nil {
+		return nil, err
+	}
+	return CreateNewModelTrimResponse(trimResult), nil
+}
@@ -0,0 +1,17 @@
+package new_model_trim
+
+import "github.com/google/uuid"
+
+type NewModelTrimRequest struct {
+	ModelID            uuid.UUID `json:"-" uri:"id"`
+	Name               string    `json:"name"`
+	EngineDisplacement int       `json:"enginedisplacement"` // Cilindrada en cm³; 0 para las versiones eléctricas
+	Power              int       `json:"power"`              // Potencia en CV
+	FuelType           string    `json:"fueltype"`
+	Transmission       string    `json:"transmission"`
+	Drivetrain         string    `json:"drivetrain"`
+	Doors              int       `json:"doors"`
+	Seats              int       `json:"seats"`
+	CurbWeight         int       `json:"curbweight"`   // Opcional; peso en orden de marcha en kg
+	CO2Emissions       int       `json:"co2emissions"` // Opcional; emisiones en g/km
+}
@@ -0,0 +1,40 @@
+package new_model_trim
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type NewModelTrimResponse struct {
+	ID                 string                `json:"id"`
+	ModelID            string                `json:"modelId"`
+	Name               string                `json:"name"`
+	EngineDisplacement int                   `json:"engineDisplacement"`
+	Power              int                   `json:"power"`
+	FuelType           entities.FuelType     `json:"fuelType"`
+	Transmission       entities.Transmission `json:"transmission"`
+	Drivetrain         entities.Drivetrain   `json:"drivetrain"`
+	Doors              int                   `json:"doors"`
+	Seats              int                   `json:"seats"`
+	CurbWeight         int                   `json:"curbWeight"`
+	CO2Emissions       int                   `json:"co2Emissions"`
+	CreatedAt          time.Time             `json:"createdAt"`
+}
+
+func CreateNewModelTrimResponse(trim *entities.ModelTrim) *NewModelTrimResponse {
+	return &NewModelTrimResponse{
+		ID:                 trim.ID.String(),
+		ModelID:            trim.ModelID.String(),
+		Name:               trim.Name,
+		EngineDisplacement: trim.EngineDisplacement,
+		Power:              trim.Power,
+		FuelType:           trim.FuelType,
+		Transmission:       trim.Transmission,
+		Drivetrain:         trim.Drivetrain,
+		Doors:              trim.Doors,
+		Seats:              trim.Seats,
+		CurbWeight:         trim.CurbWeight,
+		CO2Emissions:       trim.CO2Emissions,
+		CreatedAt:          trim.CreatedAt,
+	}
+}
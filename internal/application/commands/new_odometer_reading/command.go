@@ -0,0 +1,66 @@
+//internal/application/commands/new_odometer_reading/command.go
+
+package new_odometer_reading
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "CreateOdometerReading"
+
+type NewOdometerReadingCommand struct {
+	service services.OdometerService
+}
+
+func CreateNewOdometerReadingCommand(service services.OdometerService) *NewOdometerReadingCommand {
+	return &NewOdometerReadingCommand{
+		service: service,
+	}
+}
+
+func (c *NewOdometerReadingCommand) Validate(request api.CommandRequest[NewOdometerReadingRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	readingRequest := request.Data
+	if readingRequest.CarID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del vehículo es requerido",
+		})
+	}
+
+	if readingRequest.Value < 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "value",
+			Message: "El kilometraje no puede ser negativo",
+		})
+	}
+
+	if readingRequest.Date != nil && readingRequest.Date.After(time.Now()) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "date",
+			Message: "La fecha de la lectura no puede ser futura",
+		})
+	}
+	return errors
+}
+
+func (c *NewOdometerReadingCommand) Execute(request api.CommandRequest[NewOdometerReadingRequest], ctx *context.Context) (*NewOdometerReadingResponse, error) {
+	readingRequest := request.Data
+	date := time.Now()
+	if readingRequest.Date != nil {
+		date = *readingRequest.Date
+	}
+
+	reading := entities.NewOdometerReading(readingRequest.CarID, date, readingRequest.Value, entities.OdometerSourceManual, nil, readingRequest.Notes)
+	readingResult, err := c.service.RecordReading(*ctx, reading)
+	if err != nil {
+		return nil, err
+	}
+	return CreateNewOdometerReadingResponse(readingResult), nil
+}
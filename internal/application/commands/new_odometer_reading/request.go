@@ -0,0 +1,14 @@
+package new_odometer_reading
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NewOdometerReadingRequest struct {
+	CarID uuid.UUID  `json:"-" uri:"id"`
+	Date  *time.Time `json:"date"` // Opcional; por defecto la fecha actual
+	Value int        `json:"value"`
+	Notes string     `json:"notes"`
+}
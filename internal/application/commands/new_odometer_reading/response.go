@@ -0,0 +1,30 @@
+package new_odometer_reading
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type NewOdometerReadingResponse struct {
+	ID        string                   `json:"id"`
+	CarID     string                   `json:"carId"`
+	Date      time.Time                `json:"date"`
+	Value     int                      `json:"value"`
+	Source    entities.OdometerSource  `json:"source"`
+	Anomaly   entities.OdometerAnomaly `json:"anomaly,omitempty"`
+	Notes     string                   `json:"notes"`
+	CreatedAt time.Time                `json:"createdAt"`
+}
+
+func CreateNewOdometerReadingResponse(reading *entities.OdometerReading) *NewOdometerReadingResponse {
+	return &NewOdometerReadingResponse{
+		ID:        reading.ID.String(),
+		CarID:     reading.CarID.String(),
+		Date:      reading.Date,
+		Value:     reading.Value,
+		Source:    reading.Source,
+		Anomaly:   reading.Anomaly,
+		Notes:     reading.Notes,
+		CreatedAt: reading.CreatedAt,
+	}
+}
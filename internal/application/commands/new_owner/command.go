@@ -0,0 +1,63 @@
+//internal/application/commands/new_owner/command.go
+
+package new_owner
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"context"
+	"net/mail"
+	"strings"
+)
+
+const Name = "CreateOwner"
+
+type NewOwnerCommand struct {
+	service services.OwnerService
+}
+
+func CreateNewOwnerCommand(service services.OwnerService) *NewOwnerCommand {
+	return &NewOwnerCommand{
+		service: service,
+	}
+}
+
+func (c *NewOwnerCommand) Validate(request api.CommandRequest[NewOwnerRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	ownerRequest := request.Data
+	if strings.TrimSpace(ownerRequest.Name) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "name",
+			Message: "El nombre es requerido",
+		})
+	}
+
+	if ownerRequest.Email == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "email",
+			Message: "El email es requerido",
+		})
+	} else if _, err := mail.ParseAddress(ownerRequest.Email); err != nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "email",
+			Message: "El email no tiene un formato válido",
+		})
+	}
+	return errors
+}
+
+func (c *NewOwnerCommand) Execute(request api.CommandRequest[NewOwnerRequest], ctx *context.Context) (*NewOwnerResponse, error) {
+	ownerRequest := request.Data
+	owner := entities.NewOwner(
+		strings.TrimSpace(ownerRequest.Name),
+		strings.ToLower(ownerRequest.Email),
+		ownerRequest.Phone,
+		ownerRequest.Address,
+	)
+	ownerResult, err := c.service.CreateOwner(*ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	return CreateOwnerResponse(ownerResult), nil
+}
@@ -0,0 +1,8 @@
+package new_owner
+
+type NewOwnerRequest struct {
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Phone   string `json:"phone"`
+	Address string `json:"address"`
+}
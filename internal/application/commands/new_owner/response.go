@@ -0,0 +1,26 @@
+package new_owner
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type NewOwnerResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Phone     string    `json:"phone"`
+	Address   string    `json:"address"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func CreateOwnerResponse(owner *entities.Owner) *NewOwnerResponse {
+	return &NewOwnerResponse{
+		ID:        owner.ID.String(),
+		Name:      owner.Name,
+		Email:     owner.Email,
+		Phone:     owner.Phone,
+		Address:   owner.Address,
+		CreatedAt: owner.CreatedAt,
+	}
+}
@@ -0,0 +1,126 @@
+//internal/application/commands/new_recall/command.go
+
+package new_recall
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/recall"
+	"car-service/internal/domain/services"
+	"car-service/internal/domain/vin"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "CreateRecall"
+
+type NewRecallCommand struct {
+	service services.RecallService
+}
+
+func CreateNewRecallCommand(service services.RecallService) *NewRecallCommand {
+	return &NewRecallCommand{
+		service: service,
+	}
+}
+
+func (c *NewRecallCommand) Validate(request api.CommandRequest[NewRecallRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	recallRequest := request.Data
+	if recallRequest.ModelID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "modelId",
+			Message: "El ID del modelo es requerido",
+		})
+	}
+
+	if strings.TrimSpace(recallRequest.Campaign) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "campaign",
+			Message: "El número de campaña es requerido",
+		})
+	}
+
+	if strings.TrimSpace(recallRequest.Description) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "description",
+			Message: "La descripción es requerida",
+		})
+	}
+
+	if recallRequest.YearFrom < 0 || recallRequest.YearTo < 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "yearFrom",
+			Message: "Los años afectados no pueden ser negativos",
+		})
+	} else if recallRequest.YearFrom != 0 && recallRequest.YearTo != 0 && recallRequest.YearTo < recallRequest.YearFrom {
+		errors = append(errors, &api.ValidationError{
+			Field:   "yearTo",
+			Message: "El último año afectado debe ser mayor o igual al primero",
+		})
+	}
+
+	validRange := true
+	if recallRequest.VINFrom != "" {
+		if err := vin.Validate(recallRequest.VINFrom); err != nil {
+			validRange = false
+			errors = append(errors, &api.ValidationError{
+				Field:   "vinFrom",
+				Message: err.Error(),
+			})
+		}
+	}
+	if recallRequest.VINTo != "" {
+		if err := vin.Validate(recallRequest.VINTo); err != nil {
+			validRange = false
+			errors = append(errors, &api.ValidationError{
+				Field:   "vinTo",
+				Message: err.Error(),
+			})
+		}
+	}
+	if validRange && recallRequest.VINFrom != "" && recallRequest.VINTo != "" &&
+		!recall.InVINRange(recallRequest.VINTo, recallRequest.VINFrom, "") {
+		errors = append(errors, &api.ValidationError{
+			Field:   "vinTo",
+			Message: "El último VIN del rango debe ser posterior al primero",
+		})
+	}
+
+	if recallRequest.IssuedAt != nil && recallRequest.IssuedAt.After(time.Now()) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "issuedAt",
+			Message: "La fecha de emisión no puede ser futura",
+		})
+	}
+	return errors
+}
+
+func (c *NewRecallCommand) Execute(request api.CommandRequest[NewRecallRequest], ctx *context.Context) (*NewRecallResponse, error) {
+	recallRequest := request.Data
+	issuedAt := time.Now()
+	if recallRequest.IssuedAt != nil {
+		issuedAt = *recallRequest.IssuedAt
+	}
+
+	record := entities.NewRecall(
+		recallRequest.ModelID,
+		strings.TrimSpace(recallRequest.Campaign),
+		strings.TrimSpace(recallRequest.Description),
+		strings.TrimSpace(recallRequest.Component),
+		strings.TrimSpace(recallRequest.Remedy),
+		recallRequest.YearFrom,
+		recallRequest.YearTo,
+		recallRequest.VINFrom,
+		recallRequest.VINTo,
+		issuedAt,
+	)
+	detail, err := c.service.CreateRecall(*ctx, record)
+	if err != nil {
+		return nil, err
+	}
+	return CreateNewRecallResponse(detail), nil
+}
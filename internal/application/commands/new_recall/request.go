@@ -0,0 +1,20 @@
+package new_recall
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NewRecallRequest struct {
+	ModelID     uuid.UUID  `json:"modelid"`
+	Campaign    string     `json:"campaign"`
+	Description string     `json:"description"`
+	Component   string     `json:"component"`
+	Remedy      string     `json:"remedy"`
+	YearFrom    int        `json:"yearfrom"` // Opcional; 0 sin límite
+	YearTo      int        `json:"yearto"`   // Opcional; 0 sin límite
+	VINFrom     string     `json:"vinfrom"`  // Opcional; primer VIN del rango afectado
+	VINTo       string     `json:"vinto"`    // Opcional; último VIN del rango afectado
+	IssuedAt    *time.Time `json:"issuedat"` // Opcional; por defecto la fecha actual
+}
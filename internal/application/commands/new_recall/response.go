@@ -0,0 +1,41 @@
+package new_recall
+
+import (
+	"car-service/internal/domain/services"
+	"time"
+)
+
+type NewRecallResponse struct {
+	ID           string    `json:"id"`
+	ModelID      string    `json:"modelId"`
+	Campaign     string    `json:"campaign"`
+	Description  string    `json:"description"`
+	Component    string    `json:"component"`
+	Remedy       string    `json:"remedy"`
+	YearFrom     int       `json:"yearFrom"`
+	YearTo       int       `json:"yearTo"`
+	VINFrom      string    `json:"vinFrom"`
+	VINTo        string    `json:"vinTo"`
+	IssuedAt     time.Time `json:"issuedAt"`
+	AffectedCars int       `json:"affectedCars"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+func CreateNewRecallResponse(detail *services.RecallDetail) *NewRecallResponse {
+	recall := detail.Recall
+	return &NewRecallResponse{
+		ID:           recall.ID.String(),
+		ModelID:      recall.ModelID.String(),
+		Campaign:     recall.Campaign,
+		Description:  recall.Description,
+		Component:    recall.Component,
+		Remedy:       recall.Remedy,
+		YearFrom:     recall.YearFrom,
+		YearTo:       recall.YearTo,
+		VINFrom:      recall.VINFrom,
+		VINTo:        recall.VINTo,
+		IssuedAt:     recall.IssuedAt,
+		AffectedCars: detail.Affected,
+		CreatedAt:    recall.CreatedAt,
+	}
+}
@@ -0,0 +1,101 @@
+//internal/application/commands/new_registration/command.go
+
+package new_registration
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/plate"
+	"car-service/internal/domain/services"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "CreateRegistration"
+
+type NewRegistrationCommand struct {
+	service services.RegistrationService
+}
+
+func CreateNewRegistrationCommand(service services.RegistrationService) *NewRegistrationCommand {
+	return &NewRegistrationCommand{
+		service: service,
+	}
+}
+
+func (c *NewRegistrationCommand) Validate(request api.CommandRequest[NewRegistrationRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	registrationRequest := request.Data
+	if registrationRequest.CarID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del vehículo es requerido",
+		})
+	}
+
+	if strings.TrimSpace(registrationRequest.Country) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "country",
+			Message: "El país es requerido",
+		})
+	}
+
+	if strings.TrimSpace(registrationRequest.Plate) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "plate",
+			Message: "La patente es requerida",
+		})
+	} else if registrationRequest.Country != "" {
+		if _, err := plate.Validate(registrationRequest.Country, registrationRequest.Plate); err != nil {
+			errors = append(errors, &api.ValidationError{
+				Field:   "plate",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	issuedAt := time.Now()
+	if registrationRequest.IssuedAt != nil {
+		issuedAt = *registrationRequest.IssuedAt
+		if issuedAt.After(time.Now()) {
+			errors = append(errors, &api.ValidationError{
+				Field:   "issuedAt",
+				Message: "La fecha de emisión no puede ser futura",
+			})
+		}
+	}
+
+	if registrationRequest.ExpiresAt != nil && !registrationRequest.ExpiresAt.After(issuedAt) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "expiresAt",
+			Message: "La fecha de vencimiento debe ser posterior a la fecha de emisión",
+		})
+	}
+	return errors
+}
+
+func (c *NewRegistrationCommand) Execute(request api.CommandRequest[NewRegistrationRequest], ctx *context.Context) (*NewRegistrationResponse, error) {
+	registrationRequest := request.Data
+	issuedAt := time.Now()
+	if registrationRequest.IssuedAt != nil {
+		issuedAt = *registrationRequest.IssuedAt
+	}
+
+	registration := entities.NewRegistration(
+		registrationRequest.CarID,
+		registrationRequest.Plate,
+		registrationRequest.Country,
+		strings.TrimSpace(registrationRequest.Region),
+		"",
+		issuedAt,
+		registrationRequest.ExpiresAt,
+	)
+	registrationResult, err := c.service.RegisterPlate(*ctx, registration)
+	if err != nil {
+		return nil, err
+	}
+	return CreateNewRegistrationResponse(registrationResult), nil
+}
@@ -0,0 +1,16 @@
+package new_registration
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NewRegistrationRequest struct {
+	CarID     uuid.UUID  `json:"-" uri:"id"`
+	Plate     string     `json:"plate"`
+	Country   string     `json:"country"` // Código ISO 3166-1 alfa-2
+	Region    string     `json:"region"`
+	IssuedAt  *time.Time `json:"issuedat"` // Opcional; por defecto la fecha actual
+	ExpiresAt *time.Time `json:"expiresat"`
+}
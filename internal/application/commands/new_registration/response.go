@@ -0,0 +1,32 @@
+package new_registration
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type NewRegistrationResponse struct {
+	ID        string     `json:"id"`
+	CarID     string     `json:"carId"`
+	Plate     string     `json:"plate"`
+	Country   string     `json:"country"`
+	Region    string     `json:"region"`
+	Format    string     `json:"format"`
+	IssuedAt  time.Time  `json:"issuedAt"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+func CreateNewRegistrationResponse(registration *entities.Registration) *NewRegistrationResponse {
+	return &NewRegistrationResponse{
+		ID:        registration.ID.String(),
+		CarID:     registration.CarID.String(),
+		Plate:     registration.Plate,
+		Country:   registration.Country,
+		Region:    registration.Region,
+		Format:    registration.Format,
+		IssuedAt:  registration.IssuedAt,
+		ExpiresAt: registration.ExpiresAt,
+		CreatedAt: registration.CreatedAt,
+	}
+}
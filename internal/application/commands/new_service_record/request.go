@@ -0,0 +1,25 @@
+package new_service_record
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NewServiceRecordRequest struct {
+	CarID          uuid.UUID                `json:"-" uri:"id"`
+	Date           time.Time                `json:"date"`
+	Odometer       int                      `json:"odometer"`
+	Workshop       string                   `json:"workshop"`
+	Type           string                   `json:"type"`
+	ComponentGroup string                   `json:"componentgroup"` // Grupo de componentes intervenido; se usa para evaluar la garantía
+	LineItems      []ServiceLineItemRequest `json:"lineitems"`
+	Cost           float64                  `json:"cost"` // Se ignora si se informan ítems
+	Notes          string                   `json:"notes"`
+}
+
+type ServiceLineItemRequest struct {
+	Description string  `json:"description"`
+	Quantity    float64 `json:"quantity"`
+	UnitPrice   float64 `json:"unitprice"`
+}
@@ -0,0 +1,83 @@
+//internal/application/commands/new_stolen_report/command.go
+
+package new_stolen_report
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"car-service/internal/domain/vin"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "CreateStolenReport"
+
+type NewStolenReportCommand struct {
+	service services.StolenReportService
+}
+
+func CreateNewStolenReportCommand(service services.StolenReportService) *NewStolenReportCommand {
+	return &NewStolenReportCommand{
+		service: service,
+	}
+}
+
+func (c *NewStolenReportCommand) Validate(request api.CommandRequest[NewStolenReportRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	reportRequest := request.Data
+	if (reportRequest.CarId == nil || *reportRequest.CarId == uuid.Nil) && reportRequest.Vin == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "vin",
+			Message: "Se requiere el ID del vehículo o su VIN",
+		})
+	}
+
+	if reportRequest.Vin != "" {
+		if err := vin.Validate(reportRequest.Vin); err != nil {
+			errors = append(errors, &api.ValidationError{
+				Field:   "vin",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if strings.TrimSpace(reportRequest.PoliceReference) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "policeReference",
+			Message: "El número de denuncia policial es requerido",
+		})
+	}
+
+	if reportRequest.ReportDate != nil && reportRequest.ReportDate.After(time.Now()) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "reportDate",
+			Message: "La fecha de la denuncia no puede ser futura",
+		})
+	}
+	return errors
+}
+
+func (c *NewStolenReportCommand) Execute(request api.CommandRequest[NewStolenReportRequest], ctx *context.Context) (*NewStolenReportResponse, error) {
+	reportRequest := request.Data
+	reportDate := time.Now()
+	if reportRequest.ReportDate != nil {
+		reportDate = *reportRequest.ReportDate
+	}
+
+	var carID *uuid.UUID
+	if reportRequest.CarId != nil && *reportRequest.CarId != uuid.Nil {
+		carID = reportRequest.CarId
+	}
+
+	report := entities.NewStolenReport(vin.Normalize(reportRequest.Vin), carID, reportDate,
+		strings.TrimSpace(reportRequest.PoliceReference), reportRequest.Notes)
+	reportResult, err := c.service.ReportStolen(*ctx, report)
+	if err != nil {
+		return nil, err
+	}
+	return CreateNewStolenReportResponse(reportResult), nil
+}
@@ -0,0 +1,16 @@
+package new_stolen_report
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NewStolenReportRequest identifica el vehículo por su ID o por su VIN
+type NewStolenReportRequest struct {
+	CarId           *uuid.UUID `json:"carid"`
+	Vin             string     `json:"vin"`
+	ReportDate      *time.Time `json:"reportdate"` // Opcional; por defecto la fecha actual
+	PoliceReference string     `json:"policereference"`
+	Notes           string     `json:"notes"`
+}
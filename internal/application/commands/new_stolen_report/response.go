@@ -0,0 +1,32 @@
+package new_stolen_report
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type NewStolenReportResponse struct {
+	ID              string    `json:"id"`
+	CarID           *string   `json:"carId"`
+	VIN             string    `json:"vin"`
+	ReportDate      time.Time `json:"reportDate"`
+	PoliceReference string    `json:"policeReference"`
+	Notes           string    `json:"notes"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+func CreateNewStolenReportResponse(report *entities.StolenReport) *NewStolenReportResponse {
+	response := &NewStolenReportResponse{
+		ID:              report.ID.String(),
+		VIN:             report.VIN,
+		ReportDate:      report.ReportDate,
+		PoliceReference: report.PoliceReference,
+		Notes:           report.Notes,
+		CreatedAt:       report.CreatedAt,
+	}
+	if report.CarID != nil {
+		carID := report.CarID.String()
+		response.CarID = &carID
+	}
+	return response
+}
@@ -0,0 +1,110 @@
+//internal/application/commands/new_warranty_template/command.go
+
+package new_warranty_template
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const Name = "CreateWarrantyTemplate"
+
+type NewWarrantyTemplateCommand struct {
+	service services.WarrantyService
+}
+
+func CreateNewWarrantyTemplateCommand(service services.WarrantyService) *NewWarrantyTemplateCommand {
+	return &NewWarrantyTemplateCommand{
+		service: service,
+	}
+}
+
+func (c *NewWarrantyTemplateCommand) Validate(request api.CommandRequest[NewWarrantyTemplateRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	templateRequest := request.Data
+	if templateRequest.BrandID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "brandId",
+			Message: "El ID de la marca es requerido",
+		})
+	}
+
+	if templateRequest.ModelID != nil && *templateRequest.ModelID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "modelId",
+			Message: "El ID del modelo no es válido",
+		})
+	}
+
+	if strings.TrimSpace(templateRequest.Name) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "name",
+			Message: "El nombre de la plantilla es requerido",
+		})
+	}
+
+	if len(templateRequest.Coverages) == 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "coverages",
+			Message: "La plantilla debe tener al menos una cobertura",
+		})
+	}
+
+	groups := make(map[entities.ComponentGroup]bool, len(templateRequest.Coverages))
+	for i, coverage := range templateRequest.Coverages {
+		group := entities.ComponentGroup(coverage.ComponentGroup)
+		if !group.IsValid() {
+			errors = append(errors, &api.ValidationError{
+				Field:   fmt.Sprintf("coverages[%d].componentGroup", i),
+				Message: fmt.Sprintf("Grupo de componentes no soportado: %s", coverage.ComponentGroup),
+			})
+		} else if groups[group] {
+			errors = append(errors, &api.ValidationError{
+				Field:   fmt.Sprintf("coverages[%d].componentGroup", i),
+				Message: fmt.Sprintf("El grupo de componentes %s está repetido", group),
+			})
+		}
+		groups[group] = true
+
+		if coverage.Months <= 0 {
+			errors = append(errors, &api.ValidationError{
+				Field:   fmt.Sprintf("coverages[%d].months", i),
+				Message: "La duración en meses debe ser mayor a 0",
+			})
+		}
+		if coverage.OdometerLimit < 0 {
+			errors = append(errors, &api.ValidationError{
+				Field:   fmt.Sprintf("coverages[%d].odometerLimit", i),
+				Message: "El kilometraje límite no puede ser negativo",
+			})
+		}
+	}
+	return errors
+}
+
+func (c *NewWarrantyTemplateCommand) Execute(request api.CommandRequest[NewWarrantyTemplateRequest], ctx *context.Context) (*NewWarrantyTemplateResponse, error) {
+	templateRequest := request.Data
+	coverages := make([]entities.WarrantyCoverage, len(templateRequest.Coverages))
+	for i, coverage := range templateRequest.Coverages {
+		coverages[i] = entities.NewWarrantyCoverage(entities.ComponentGroup(coverage.ComponentGroup), coverage.Months, coverage.OdometerLimit)
+	}
+
+	template := entities.NewWarrantyTemplate(
+		templateRequest.BrandID,
+		templateRequest.ModelID,
+		strings.TrimSpace(templateRequest.Name),
+		coverages,
+	)
+
+	templateResult, err := c.service.CreateTemplate(*ctx, template)
+	if err != nil {
+		return nil, err
+	}
+	return CreateNewWarrantyTemplateResponse(templateResult), nil
+}
@@ -0,0 +1,16 @@
+package new_warranty_template
+
+import "github.com/google/uuid"
+
+type NewWarrantyTemplateRequest struct {
+	BrandID   uuid.UUID                 `json:"brandid"`
+	ModelID   *uuid.UUID                `json:"modelid"` // Opcional; sin modelo la plantilla aplica a toda la marca
+	Name      string                    `json:"name"`
+	Coverages []WarrantyCoverageRequest `json:"coverages"`
+}
+
+type WarrantyCoverageRequest struct {
+	ComponentGroup string `json:"componentgroup"`
+	Months         int    `json:"months"`
+	OdometerLimit  int    `json:"odometerlimit"` // Opcional; 0 sin límite de kilometraje
+}
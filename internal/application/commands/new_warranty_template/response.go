@@ -0,0 +1,31 @@
+package new_warranty_template
+
+import (
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type NewWarrantyTemplateResponse struct {
+	ID        string                          `json:"id"`
+	BrandID   string                          `json:"brandId"`
+	ModelID   *string                         `json:"modelId"`
+	Name      string                          `json:"name"`
+	Coverages []*dto.WarrantyCoverageResponse `json:"coverages"`
+	CreatedAt time.Time                       `json:"createdAt"`
+}
+
+func CreateNewWarrantyTemplateResponse(template *entities.WarrantyTemplate) *NewWarrantyTemplateResponse {
+	response := &NewWarrantyTemplateResponse{
+		ID:        template.ID.String(),
+		BrandID:   template.BrandID.String(),
+		Name:      template.Name,
+		Coverages: dto.CreateWarrantyCoverageResponses(template.Coverages),
+		CreatedAt: template.CreatedAt,
+	}
+	if template.ModelID != nil {
+		modelID := template.ModelID.String()
+		response.ModelID = &modelID
+	}
+	return response
+}
@@ -0,0 +1,97 @@
+//internal/application/commands/patch_car/command.go
+
+package patch_car
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"car-service/internal/domain/vin"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "PatchCar"
+
+type PatchCarCommand struct {
+	service services.CarService
+}
+
+func CreatePatchCarCommand(service services.CarService) *PatchCarCommand {
+	return &PatchCarCommand{
+		service: service,
+	}
+}
+
+func (c *PatchCarCommand) Validate(request api.CommandRequest[PatchCarRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	carRequest := request.Data
+	if carRequest.ModelId != nil && *carRequest.ModelId == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "modelId",
+			Message: "El ID del modelo no puede estar vacío",
+		})
+	}
+
+	if carRequest.OwnerId != nil && *carRequest.OwnerId == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "ownerId",
+			Message: "El ID del propietario no puede estar vacío",
+		})
+	}
+
+	if carRequest.Vin != nil {
+		if err := vin.Validate(*carRequest.Vin); err != nil {
+			errors = append(errors, &api.ValidationError{
+				Field:   "vin",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if carRequest.Year != nil && (*carRequest.Year < 1900 || *carRequest.Year > time.Now().Year()+1) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "year",
+			Message: "El año debe estar entre 1900 y el año siguiente al actual",
+		})
+	}
+	return errors
+}
+
+func (c *PatchCarCommand) Execute(request api.CommandRequest[PatchCarRequest], ctx *context.Context) (*PatchCarResponse, error) {
+	carRequest := request.Data
+	car, err := c.service.GetCar(*ctx, carRequest.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if carRequest.ModelId != nil && *carRequest.ModelId != car.ModelID {
+		car.ModelID = *carRequest.ModelId
+		car.TrimID = nil
+	}
+	if carRequest.TrimId != nil {
+		car.TrimID = carRequest.TrimId
+		if *carRequest.TrimId == uuid.Nil {
+			car.TrimID = nil
+		}
+	}
+	if carRequest.OwnerId != nil {
+		car.OwnerID = *carRequest.OwnerId
+	}
+	if carRequest.Year != nil {
+		car.Year = *carRequest.Year
+	}
+	if carRequest.Color != nil {
+		car.Color = *carRequest.Color
+	}
+	if carRequest.Vin != nil {
+		car.VIN = vin.Normalize(*carRequest.Vin)
+	}
+
+	carResult, err := c.service.UpdateCar(*ctx, car)
+	if err != nil {
+		return nil, err
+	}
+	return CreatePatchCarResponse(carResult), nil
+}
@@ -0,0 +1,14 @@
+package patch_car
+
+import "github.com/google/uuid"
+
+// PatchCarRequest contiene únicamente los campos a modificar; los campos nulos se conservan
+type PatchCarRequest struct {
+	ID      uuid.UUID  `json:"-" uri:"id"`
+	ModelId *uuid.UUID `json:"modelid"` // Si cambia el modelo y no se informa la versión, se quita la asignada
+	TrimId  *uuid.UUID `json:"trimid"`  // El UUID nulo quita la versión asignada
+	OwnerId *uuid.UUID `json:"ownerid"`
+	Year    *int       `json:"year"`
+	Color   *string    `json:"color"`
+	Vin     *string    `json:"vin"` // Si se envía debe coincidir con el VIN registrado
+}
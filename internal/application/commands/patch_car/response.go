@@ -0,0 +1,36 @@
+package patch_car
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type PatchCarResponse struct {
+	ID        string    `json:"id"`
+	ModelID   string    `json:"modelId"`
+	TrimID    *string   `json:"trimId"`
+	OwnerID   string    `json:"ownerId"`
+	Year      int       `json:"year"`
+	Color     string    `json:"color"`
+	VIN       string    `json:"vin"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func CreatePatchCarResponse(car *entities.Car) *PatchCarResponse {
+	response := &PatchCarResponse{
+		ID:        car.ID.String(),
+		ModelID:   car.ModelID.String(),
+		OwnerID:   car.OwnerID.String(),
+		Year:      car.Year,
+		Color:     car.Color,
+		VIN:       car.VIN,
+		CreatedAt: car.CreatedAt,
+		UpdatedAt: car.UpdatedAt,
+	}
+	if car.TrimID != nil {
+		trimID := car.TrimID.String()
+		response.TrimID = &trimID
+	}
+	return response
+}
@@ -0,0 +1,55 @@
+//internal/application/commands/recover_stolen_report/command.go
+
+package recover_stolen_report
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "RecoverStolenReport"
+
+type RecoverStolenReportCommand struct {
+	service services.StolenReportService
+}
+
+func CreateRecoverStolenReportCommand(service services.StolenReportService) *RecoverStolenReportCommand {
+	return &RecoverStolenReportCommand{
+		service: service,
+	}
+}
+
+func (c *RecoverStolenReportCommand) Validate(request api.CommandRequest[RecoverStolenReportRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if request.Data.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID de la denuncia es requerido",
+		})
+	}
+
+	if request.Data.RecoveredDate != nil && request.Data.RecoveredDate.After(time.Now()) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "recoveredDate",
+			Message: "La fecha de recupero no puede ser futura",
+		})
+	}
+	return errors
+}
+
+func (c *RecoverStolenReportCommand) Execute(request api.CommandRequest[RecoverStolenReportRequest], ctx *context.Context) (*RecoverStolenReportResponse, error) {
+	recoveredDate := time.Now()
+	if request.Data.RecoveredDate != nil {
+		recoveredDate = *request.Data.RecoveredDate
+	}
+
+	report, err := c.service.RecoverStolen(*ctx, request.Data.ID, recoveredDate)
+	if err != nil {
+		return nil, err
+	}
+	return CreateRecoverStolenReportResponse(report), nil
+}
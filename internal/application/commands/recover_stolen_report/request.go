@@ -0,0 +1,12 @@
+package recover_stolen_report
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RecoverStolenReportRequest struct {
+	ID            uuid.UUID  `json:"-" uri:"id"`
+	RecoveredDate *time.Time `json:"recovereddate"` // Opcional; por defecto la fecha actual
+}
@@ -0,0 +1,22 @@
+package recover_stolen_report
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type RecoverStolenReportResponse struct {
+	ID            string     `json:"id"`
+	VIN           string     `json:"vin"`
+	RecoveredDate *time.Time `json:"recoveredDate"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
+
+func CreateRecoverStolenReportResponse(report *entities.StolenReport) *RecoverStolenReportResponse {
+	return &RecoverStolenReportResponse{
+		ID:            report.ID.String(),
+		VIN:           report.VIN,
+		RecoveredDate: report.RecoveredDate,
+		UpdatedAt:     report.UpdatedAt,
+	}
+}
@@ -0,0 +1,62 @@
+//internal/application/commands/terminate_insurance_policy/command.go
+
+package terminate_insurance_policy
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "TerminateInsurancePolicy"
+
+type TerminateInsurancePolicyCommand struct {
+	service services.InsurancePolicyService
+}
+
+func CreateTerminateInsurancePolicyCommand(service services.InsurancePolicyService) *TerminateInsurancePolicyCommand {
+	return &TerminateInsurancePolicyCommand{
+		service: service,
+	}
+}
+
+func (c *TerminateInsurancePolicyCommand) Validate(request api.CommandRequest[TerminateInsurancePolicyRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if request.Data.CarID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del vehículo es requerido",
+		})
+	}
+
+	if request.Data.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "policyId",
+			Message: "El ID de la póliza es requerido",
+		})
+	}
+
+	if request.Data.TerminatedAt != nil && request.Data.TerminatedAt.After(time.Now()) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "terminatedAt",
+			Message: "La fecha de baja no puede ser futura",
+		})
+	}
+	return errors
+}
+
+func (c *TerminateInsurancePolicyCommand) Execute(request api.CommandRequest[TerminateInsurancePolicyRequest], ctx *context.Context) (*TerminateInsurancePolicyResponse, error) {
+	terminatedAt := time.Now()
+	if request.Data.TerminatedAt != nil {
+		terminatedAt = *request.Data.TerminatedAt
+	}
+
+	policy, err := c.service.TerminatePolicy(*ctx, request.Data.CarID, request.Data.ID, terminatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return CreateTerminateInsurancePolicyResponse(policy), nil
+}
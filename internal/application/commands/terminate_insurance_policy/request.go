@@ -0,0 +1,13 @@
+package terminate_insurance_policy
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TerminateInsurancePolicyRequest struct {
+	CarID        uuid.UUID  `json:"-" uri:"id"`
+	ID           uuid.UUID  `json:"-" uri:"policyId"`
+	TerminatedAt *time.Time `json:"terminatedat"` // Opcional; por defecto la fecha actual
+}
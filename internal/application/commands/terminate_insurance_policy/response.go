@@ -0,0 +1,22 @@
+package terminate_insurance_policy
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type TerminateInsurancePolicyResponse struct {
+	ID           string     `json:"id"`
+	PolicyNumber string     `json:"policyNumber"`
+	TerminatedAt *time.Time `json:"terminatedAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+}
+
+func CreateTerminateInsurancePolicyResponse(policy *entities.InsurancePolicy) *TerminateInsurancePolicyResponse {
+	return &TerminateInsurancePolicyResponse{
+		ID:           policy.ID.String(),
+		PolicyNumber: policy.PolicyNumber,
+		TerminatedAt: policy.TerminatedAt,
+		UpdatedAt:    policy.UpdatedAt,
+	}
+}
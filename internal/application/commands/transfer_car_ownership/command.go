@@ -0,0 +1,77 @@
+//internal/application/commands/transfer_car_ownership/command.go
+
+package transfer_car_ownership
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "TransferCarOwnership"
+
+type TransferCarOwnershipCommand struct {
+	service services.OwnershipService
+}
+
+func CreateTransferCarOwnershipCommand(service services.OwnershipService) *TransferCarOwnershipCommand {
+	return &TransferCarOwnershipCommand{
+		service: service,
+	}
+}
+
+func (c *TransferCarOwnershipCommand) Validate(request api.CommandRequest[TransferCarOwnershipRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	transferRequest := request.Data
+	if transferRequest.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del vehículo es requerido",
+		})
+	}
+
+	if transferRequest.OwnerId == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "ownerId",
+			Message: "El ID del nuevo propietario es requerido",
+		})
+	}
+
+	if transferRequest.Date != nil && transferRequest.Date.After(time.Now()) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "date",
+			Message: "La fecha de transferencia no puede ser futura",
+		})
+	}
+
+	if transferRequest.Price != nil && *transferRequest.Price < 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "price",
+			Message: "El precio no puede ser negativo",
+		})
+	}
+	return errors
+}
+
+func (c *TransferCarOwnershipCommand) Execute(request api.CommandRequest[TransferCarOwnershipRequest], ctx *context.Context) (*TransferCarOwnershipResponse, error) {
+	transferRequest := request.Data
+	date := time.Now()
+	if transferRequest.Date != nil {
+		date = *transferRequest.Date
+	}
+
+	record, err := c.service.TransferOwnership(*ctx, services.OwnershipTransfer{
+		CarID:   transferRequest.ID,
+		OwnerID: transferRequest.OwnerId,
+		Date:    date,
+		Price:   transferRequest.Price,
+		Notes:   transferRequest.Notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return CreateTransferCarOwnershipResponse(record), nil
+}
@@ -0,0 +1,15 @@
+package transfer_car_ownership
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TransferCarOwnershipRequest struct {
+	ID      uuid.UUID  `json:"-" uri:"id"`
+	OwnerId uuid.UUID  `json:"ownerid"` // Nuevo propietario
+	Date    *time.Time `json:"date"`    // Opcional; por defecto la fecha actual
+	Price   *float64   `json:"price"`
+	Notes   string     `json:"notes"`
+}
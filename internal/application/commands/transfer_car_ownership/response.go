@@ -0,0 +1,26 @@
+package transfer_car_ownership
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type TransferCarOwnershipResponse struct {
+	ID      string    `json:"id"`
+	CarID   string    `json:"carId"`
+	OwnerID string    `json:"ownerId"`
+	From    time.Time `json:"from"`
+	Price   *float64  `json:"price,omitempty"`
+	Notes   string    `json:"notes"`
+}
+
+func CreateTransferCarOwnershipResponse(record *entities.OwnershipRecord) *TransferCarOwnershipResponse {
+	return &TransferCarOwnershipResponse{
+		ID:      record.ID.String(),
+		CarID:   record.CarID.String(),
+		OwnerID: record.OwnerID.String(),
+		From:    record.From,
+		Price:   record.Price,
+		Notes:   record.Notes,
+	}
+}
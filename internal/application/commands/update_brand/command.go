@@ -0,0 +1,54 @@
+//internal/application/commands/update_brand/command.go
+
+package update_brand
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+	"strings"
+)
+
+const Name = "UpdateBrand"
+
+type UpdateBrandCommand struct {
+	service services.BrandService
+}
+
+func CreateUpdateBrandCommand(service services.BrandService) *UpdateBrandCommand {
+	return &UpdateBrandCommand{
+		service: service,
+	}
+}
+
+func (c *UpdateBrandCommand) Validate(request api.CommandRequest[UpdateBrandRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	if strings.TrimSpace(request.Data.Name) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "name",
+			Message: "El nombre de la marca es requerido",
+		})
+	}
+	return errors
+}
+
+func (c *UpdateBrandCommand) Execute(request api.CommandRequest[UpdateBrandRequest], ctx *context.Context) (*UpdateBrandResponse, error) {
+	brandRequest := request.Data
+	brand, err := c.service.GetBrand(*ctx, brandRequest.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	brand.Name = strings.TrimSpace(brandRequest.Name)
+	brand.Country = brandRequest.Country
+	brand.LogoURL = brandRequest.LogoURL
+	if brandRequest.Active != nil {
+		brand.Active = *brandRequest.Active
+	}
+
+	brandResult, err := c.service.UpdateBrand(*ctx, brand)
+	if err != nil {
+		return nil, err
+	}
+	return CreateUpdateBrandResponse(brandResult), nil
+}
@@ -0,0 +1,11 @@
+package update_brand
+
+import "github.com/google/uuid"
+
+type UpdateBrandRequest struct {
+	ID      uuid.UUID `json:"-" uri:"id"`
+	Name    string    `json:"name"`
+	Country string    `json:"country"`
+	LogoURL string    `json:"logourl"`
+	Active  *bool     `json:"active"` // Opcional; permite reactivar o desactivar la marca
+}
@@ -0,0 +1,28 @@
+package update_brand
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type UpdateBrandResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Country   string    `json:"country"`
+	LogoURL   string    `json:"logoUrl"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func CreateUpdateBrandResponse(brand *entities.Brand) *UpdateBrandResponse {
+	return &UpdateBrandResponse{
+		ID:        brand.ID.String(),
+		Name:      brand.Name,
+		Country:   brand.Country,
+		LogoURL:   brand.LogoURL,
+		Active:    brand.Active,
+		CreatedAt: brand.CreatedAt,
+		UpdatedAt: brand.UpdatedAt,
+	}
+}
@@ -0,0 +1,90 @@
+//internal/application/commands/update_car/command.go
+
+package update_car
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"car-service/internal/domain/vin"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "UpdateCar"
+
+type UpdateCarCommand struct {
+	service services.CarService
+}
+
+func CreateUpdateCarCommand(service services.CarService) *UpdateCarCommand {
+	return &UpdateCarCommand{
+		service: service,
+	}
+}
+
+func (c *UpdateCarCommand) Validate(request api.CommandRequest[UpdateCarRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	carRequest := request.Data
+	if carRequest.ModelId == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "modelId",
+			Message: "El ID del modelo es requerido",
+		})
+	}
+
+	if carRequest.TrimId != nil && *carRequest.TrimId == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "trimId",
+			Message: "El ID de la versión no puede estar vacío",
+		})
+	}
+
+	if carRequest.OwnerId == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "ownerId",
+			Message: "El ID del propietario es requerido",
+		})
+	}
+
+	if carRequest.Vin != "" {
+		if err := vin.Validate(carRequest.Vin); err != nil {
+			errors = append(errors, &api.ValidationError{
+				Field:   "vin",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	if carRequest.Year < 1900 || carRequest.Year > time.Now().Year()+1 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "year",
+			Message: "El año debe estar entre 1900 y el año siguiente al actual",
+		})
+	}
+	return errors
+}
+
+func (c *UpdateCarCommand) Execute(request api.CommandRequest[UpdateCarRequest], ctx *context.Context) (*UpdateCarResponse, error) {
+	carRequest := request.Data
+	car, err := c.service.GetCar(*ctx, carRequest.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	car.ModelID = carRequest.ModelId
+	car.TrimID = carRequest.TrimId
+	car.OwnerID = carRequest.OwnerId
+	car.Year = carRequest.Year
+	car.Color = carRequest.Color
+	if carRequest.Vin != "" {
+		car.VIN = vin.Normalize(carRequest.Vin)
+	}
+
+	carResult, err := c.service.UpdateCar(*ctx, car)
+	if err != nil {
+		return nil, err
+	}
+	return CreateUpdateCarResponse(carResult), nil
+}
@@ -0,0 +1,13 @@
+package update_car
+
+import "github.com/google/uuid"
+
+type UpdateCarRequest struct {
+	ID      uuid.UUID  `json:"-" uri:"id"`
+	ModelId uuid.UUID  `json:"modelid"`
+	TrimId  *uuid.UUID `json:"trimid"` // Opcional; sin versión se quita la asignada
+	OwnerId uuid.UUID  `json:"ownerid"`
+	Year    int        `json:"year"`
+	Color   string     `json:"color"`
+	Vin     string     `json:"vin"` // Opcional; si se envía debe coincidir con el VIN registrado
+}
@@ -0,0 +1,81 @@
+//internal/application/commands/update_model/command.go
+
+package update_model
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "UpdateModel"
+
+type UpdateModelCommand struct {
+	service services.ModelService
+}
+
+func CreateUpdateModelCommand(service services.ModelService) *UpdateModelCommand {
+	return &UpdateModelCommand{
+		service: service,
+	}
+}
+
+func (c *UpdateModelCommand) Validate(request api.CommandRequest[UpdateModelRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	modelRequest := request.Data
+	if strings.TrimSpace(modelRequest.Name) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "name",
+			Message: "El nombre del modelo es requerido",
+		})
+	}
+
+	if modelRequest.BrandId == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "brandId",
+			Message: "El ID de la marca es requerido",
+		})
+	}
+
+	if modelRequest.StartYear < 1900 || modelRequest.StartYear > time.Now().Year()+1 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "startYear",
+			Message: "El año de inicio debe estar entre 1900 y el año siguiente al actual",
+		})
+	}
+
+	if modelRequest.EndYear != 0 && modelRequest.EndYear < modelRequest.StartYear {
+		errors = append(errors, &api.ValidationError{
+			Field:   "endYear",
+			Message: "El año de fin debe ser 0 o mayor o igual al año de inicio",
+		})
+	}
+	return errors
+}
+
+func (c *UpdateModelCommand) Execute(request api.CommandRequest[UpdateModelRequest], ctx *context.Context) (*UpdateModelResponse, error) {
+	modelRequest := request.Data
+	model, err := c.service.GetModel(*ctx, modelRequest.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	model.Name = strings.TrimSpace(modelRequest.Name)
+	model.BrandID = modelRequest.BrandId
+	model.StartYear = modelRequest.StartYear
+	model.EndYear = modelRequest.EndYear
+	model.Category = modelRequest.Category
+	if modelRequest.Active != nil {
+		model.Active = *modelRequest.Active
+	}
+
+	modelResult, err := c.service.UpdateModel(*ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	return CreateUpdateModelResponse(modelResult), nil
+}
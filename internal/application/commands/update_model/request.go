@@ -0,0 +1,13 @@
+package update_model
+
+import "github.com/google/uuid"
+
+type UpdateModelRequest struct {
+	ID        uuid.UUID `json:"-" uri:"id"`
+	Name      string    `json:"name"`
+	BrandId   uuid.UUID `json:"brandid"`
+	StartYear int       `json:"startyear"`
+	EndYear   int       `json:"endyear"` // 0 si el modelo sigue en producción
+	Category  string    `json:"category"`
+	Active    *bool     `json:"active"` // Opcional; permite reactivar o desactivar el modelo
+}
@@ -0,0 +1,32 @@
+package update_model
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type UpdateModelResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	BrandID   string    `json:"brandId"`
+	StartYear int       `json:"startYear"`
+	EndYear   int       `json:"endYear"`
+	Category  string    `json:"category"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func CreateUpdateModelResponse(model *entities.Model) *UpdateModelResponse {
+	return &UpdateModelResponse{
+		ID:        model.ID.String(),
+		Name:      model.Name,
+		BrandID:   model.BrandID.String(),
+		StartYear: model.StartYear,
+		EndYear:   model.EndYear,
+		Category:  model.Category,
+		Active:    model.Active,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
@@ -0,0 +1,155 @@
+//internal/application/commands/update_model_trim/command.go
+
+package update_model_trim
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const Name = "UpdateModelTrim"
+
+type UpdateModelTrimCommand struct {
+	service services.ModelService
+}
+
+func CreateUpdateModelTrimCommand(service services.ModelService) *UpdateModelTrimCommand {
+	return &UpdateModelTrimCommand{
+		service: service,
+	}
+}
+
+func (c *UpdateModelTrimCommand) Validate(request api.CommandRequest[UpdateModelTrimRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	trimRequest := request.Data
+	if trimRequest.ModelID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del modelo es requerido",
+		})
+	}
+
+	if trimRequest.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "trimId",
+			Message: "El ID de la versión es requerido",
+		})
+	}
+
+	if strings.TrimSpace(trimRequest.Name) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "name",
+			Message: "El nombre de la versión es requerido",
+		})
+	}
+
+	if !entities.FuelType(trimRequest.FuelType).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "fuelType",
+			Message: fmt.Sprintf("Combustible no soportado: %s", trimRequest.FuelType),
+		})
+	}
+
+	if trimRequest.EngineDisplacement < 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "engineDisplacement",
+			Message: "La cilindrada no puede ser negativa",
+		})
+	} else if trimRequest.EngineDisplacement == 0 && entities.FuelType(trimRequest.FuelType) != entities.FuelTypeElectric {
+		errors = append(errors, &api.ValidationError{
+			Field:   "engineDisplacement",
+			Message: "La cilindrada es requerida salvo para las versiones eléctricas",
+		})
+	} else if trimRequest.EngineDisplacement > 0 && entities.FuelType(trimRequest.FuelType) == entities.FuelTypeElectric {
+		errors = append(errors, &api.ValidationError{
+			Field:   "engineDisplacement",
+			Message: "Una versión eléctrica no tiene cilindrada",
+		})
+	}
+
+	if trimRequest.Power <= 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "power",
+			Message: "La potencia debe ser mayor a 0",
+		})
+	}
+
+	if !entities.Transmission(trimRequest.Transmission).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "transmission",
+			Message: fmt.Sprintf("Tipo de caja no soportado: %s", trimRequest.Transmission),
+		})
+	}
+
+	if !entities.Drivetrain(trimRequest.Drivetrain).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "drivetrain",
+			Message: fmt.Sprintf("Tipo de tracción no soportado: %s", trimRequest.Drivetrain),
+		})
+	}
+
+	if trimRequest.Doors < 2 || trimRequest.Doors > 5 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "doors",
+			Message: "La cantidad de puertas debe estar entre 2 y 5",
+		})
+	}
+
+	if trimRequest.Seats < 1 || trimRequest.Seats > 9 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "seats",
+			Message: "La cantidad de plazas debe estar entre 1 y 9",
+		})
+	}
+
+	if trimRequest.CurbWeight < 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "curbWeight",
+			Message: "El peso no puede ser negativo",
+		})
+	}
+
+	if trimRequest.CO2Emissions < 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "co2Emissions",
+			Message: "Las emisiones de CO2 no pueden ser negativas",
+		})
+	} else if trimRequest.CO2Emissions > 0 && entities.FuelType(trimRequest.FuelType) == entities.FuelTypeElectric {
+		errors = append(errors, &api.ValidationError{
+			Field:   "co2Emissions",
+			Message: "Una versión eléctrica no tiene emisiones de CO2",
+		})
+	}
+	return errors
+}
+
+func (c *UpdateModelTrimCommand) Execute(request api.CommandRequest[UpdateModelTrimRequest], ctx *context.Context) (*UpdateModelTrimResponse, error) {
+	trimRequest := request.Data
+	trim, err := c.service.GetModelTrim(*ctx, trimRequest.ModelID, trimRequest.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	trim.Name = strings.TrimSpace(trimRequest.Name)
+	trim.EngineDisplacement = trimRequest.EngineDisplacement
+	trim.Power = trimRequest.Power
+	trim.FuelType = entities.FuelType(trimRequest.FuelType)
+	trim.Transmission = entities.Transmission(trimRequest.Transmission)
+	trim.Drivetrain = entities.Drivetrain(trimRequest.Drivetrain)
+	trim.Doors = trimRequest.Doors
+	trim.Seats = trimRequest.Seats
+	trim.CurbWeight = trimRequest.CurbWeight
+	trim.CO2Emissions = trimRequest.CO2Emissions
+
+	trimResult, err := c.service.UpdateTrim(*ctx, trim)
+	if err != nil {
+		return nil, err
+	}
+	return CreateUpdateModelTrimResponse(trimResult), nil
+}
@@ -0,0 +1,66 @@
+//internal/application/commands/update_owner/command.go
+
+package update_owner
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/services"
+	"context"
+	"net/mail"
+	"strings"
+)
+
+const Name = "UpdateOwner"
+
+type UpdateOwnerCommand struct {
+	service services.OwnerService
+}
+
+func CreateUpdateOwnerCommand(service services.OwnerService) *UpdateOwnerCommand {
+	return &UpdateOwnerCommand{
+		service: service,
+	}
+}
+
+func (c *UpdateOwnerCommand) Validate(request api.CommandRequest[UpdateOwnerRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	ownerRequest := request.Data
+	if strings.TrimSpace(ownerRequest.Name) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "name",
+			Message: "El nombre es requerido",
+		})
+	}
+
+	if ownerRequest.Email == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "email",
+			Message: "El email es requerido",
+		})
+	} else if _, err := mail.ParseAddress(ownerRequest.Email); err != nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "email",
+			Message: "El email no tiene un formato válido",
+		})
+	}
+	return errors
+}
+
+func (c *UpdateOwnerCommand) Execute(request api.CommandRequest[UpdateOwnerRequest], ctx *context.Context) (*UpdateOwnerResponse, error) {
+	ownerRequest := request.Data
+	owner, err := c.service.GetOwner(*ctx, ownerRequest.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner.Name = strings.TrimSpace(ownerRequest.Name)
+	owner.Email = strings.ToLower(ownerRequest.Email)
+	owner.Phone = ownerRequest.Phone
+	owner.Address = ownerRequest.Address
+
+	ownerResult, err := c.service.UpdateOwner(*ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	return CreateUpdateOwnerResponse(ownerResult), nil
+}
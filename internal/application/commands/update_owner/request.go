@@ -0,0 +1,11 @@
+package update_owner
+
+import "github.com/google/uuid"
+
+type UpdateOwnerRequest struct {
+	ID      uuid.UUID `json:"-" uri:"id"`
+	Name    string    `json:"name"`
+	Email   string    `json:"email"`
+	Phone   string    `json:"phone"`
+	Address string    `json:"address"`
+}
@@ -0,0 +1,28 @@
+package update_owner
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type UpdateOwnerResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Phone     string    `json:"phone"`
+	Address   string    `json:"address"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func CreateUpdateOwnerResponse(owner *entities.Owner) *UpdateOwnerResponse {
+	return &UpdateOwnerResponse{
+		ID:        owner.ID.String(),
+		Name:      owner.Name,
+		Email:     owner.Email,
+		Phone:     owner.Phone,
+		Address:   owner.Address,
+		CreatedAt: owner.CreatedAt,
+		UpdatedAt: owner.UpdatedAt,
+	}
+}
@@ -0,0 +1,142 @@
+//internal/application/commands/update_service_record/command.go
+
+package update_service_record
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "UpdateServiceRecord"
+
+type UpdateServiceRecordCommand struct {
+	service services.ServiceRecordService
+}
+
+func CreateUpdateServiceRecordCommand(service services.ServiceRecordService) *UpdateServiceRecordCommand {
+	return &UpdateServiceRecordCommand{
+		service: service,
+	}
+}
+
+func (c *UpdateServiceRecordCommand) Validate(request api.CommandRequest[UpdateServiceRecordRequest], commandContext *api.CommandContext) []*api.ValidationError {
+	var errors []*api.ValidationError
+	serviceRequest := request.Data
+	if serviceRequest.CarID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "id",
+			Message: "El ID del vehículo es requerido",
+		})
+	}
+
+	if serviceRequest.ID == uuid.Nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "serviceId",
+			Message: "El ID del servicio es requerido",
+		})
+	}
+
+	if serviceRequest.Date.IsZero() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "date",
+			Message: "La fecha del servicio es requerida",
+		})
+	} else if serviceRequest.Date.After(time.Now()) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "date",
+			Message: "La fecha del servicio no puede ser futura",
+		})
+	}
+
+	if serviceRequest.Odometer < 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "odometer",
+			Message: "El kilometraje no puede ser negativo",
+		})
+	}
+
+	if strings.TrimSpace(serviceRequest.Workshop) == "" {
+		errors = append(errors, &api.ValidationError{
+			Field:   "workshop",
+			Message: "El taller es requerido",
+		})
+	}
+
+	if !entities.ServiceType(serviceRequest.Type).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "type",
+			Message: fmt.Sprintf("Tipo de servicio no soportado: %s", serviceRequest.Type),
+		})
+	}
+
+	if serviceRequest.ComponentGroup != "" && !entities.ComponentGroup(serviceRequest.ComponentGroup).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "componentGroup",
+			Message: fmt.Sprintf("Grupo de componentes no soportado: %s", serviceRequest.ComponentGroup),
+		})
+	}
+
+	if serviceRequest.Cost < 0 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "cost",
+			Message: "El costo no puede ser negativo",
+		})
+	}
+
+	for i, item := range serviceRequest.LineItems {
+		if strings.TrimSpace(item.Description) == "" {
+			errors = append(errors, &api.ValidationError{
+				Field:   fmt.Sprintf("lineItems[%d].description", i),
+				Message: "La descripción del ítem es requerida",
+			})
+		}
+		if item.Quantity <= 0 {
+			errors = append(errors, &api.ValidationError{
+				Field:   fmt.Sprintf("lineItems[%d].quantity", i),
+				Message: "La cantidad debe ser mayor a 0",
+			})
+		}
+		if item.UnitPrice < 0 {
+			errors = append(errors, &api.ValidationError{
+				Field:   fmt.Sprintf("lineItems[%d].unitPrice", i),
+				Message: "El precio unitario no puede ser negativo",
+			})
+		}
+	}
+	return errors
+}
+
+func (c *UpdateServiceRecordCommand) Execute(request api.CommandRequest[UpdateServiceRecordRequest], ctx *context.Context) (*UpdateServiceRecordResponse, error) {
+	serviceRequest := request.Data
+	record, err := c.service.GetServiceRecord(*ctx, serviceRequest.CarID, serviceRequest.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	record.Date = serviceRequest.Date
+	record.Odometer = serviceRequest.Odometer
+	record.Workshop = strings.TrimSpace(serviceRequest.Workshop)
+	record.Type = entities.ServiceType(serviceRequest.Type)
+	record.ComponentGroup = entities.ComponentGroup(serviceRequest.ComponentGroup)
+	record.Cost = serviceRequest.Cost
+	record.Notes = serviceRequest.Notes
+
+	items := make([]entities.ServiceLineItem, len(serviceRequest.LineItems))
+	for i, item := range serviceRequest.LineItems {
+		items[i] = entities.NewServiceLineItem(strings.TrimSpace(item.Description), item.Quantity, item.UnitPrice)
+	}
+	record.SetLineItems(items)
+
+	recordResult, err := c.service.UpdateServiceRecord(*ctx, record)
+	if err != nil {
+		return nil, err
+	}
+	return CreateUpdateServiceRecordResponse(recordResult), nil
+}
@@ -0,0 +1,41 @@
+package update_service_record
+
+import (
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type UpdateServiceRecordResponse struct {
+	ID              string                         `json:"id"`
+	CarID           string                         `json:"carId"`
+	Date            time.Time                      `json:"date"`
+	Odometer        int                            `json:"odometer"`
+	Workshop        string                         `json:"workshop"`
+	Type            entities.ServiceType           `json:"type"`
+	ComponentGroup  entities.ComponentGroup        `json:"componentGroup,omitempty"`
+	WarrantyCovered bool                           `json:"warrantyCovered"`
+	LineItems       []*dto.ServiceLineItemResponse `json:"lineItems"`
+	Cost            float64                        `json:"cost"`
+	Notes           string                         `json:"notes"`
+	CreatedAt       time.Time                      `json:"createdAt"`
+	UpdatedAt       time.Time                      `json:"updatedAt"`
+}
+
+func CreateUpdateServiceRecordResponse(record *entities.ServiceRecord) *UpdateServiceRecordResponse {
+	return &UpdateServiceRecordResponse{
+		ID:              record.ID.String(),
+		CarID:           record.CarID.String(),
+		Date:            record.Date,
+		Odometer:        record.Odometer,
+		Workshop:        record.Workshop,
+		Type:            record.Type,
+		ComponentGroup:  record.ComponentGroup,
+		WarrantyCovered: record.WarrantyCovered,
+		LineItems:       dto.CreateServiceLineItemResponses(record.LineItems),
+		Cost:            record.Cost,
+		Notes:           record.Notes,
+		CreatedAt:       record.CreatedAt,
+		UpdatedAt:       record.UpdatedAt,
+	}
+}
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+// BrandResponse es la representación de lectura de una marca
+type BrandResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Country   string    `json:"country"`
+	LogoURL   string    `json:"logoUrl"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CreateBrandResponse convierte una marca en su representación de lectura
+func CreateBrandResponse(brand *entities.Brand) *BrandResponse {
+	return &BrandResponse{
+		ID:        brand.ID.String(),
+		Name:      brand.Name,
+		Country:   brand.Country,
+		LogoURL:   brand.LogoURL,
+		Active:    brand.Active,
+		CreatedAt: brand.CreatedAt,
+		UpdatedAt: brand.UpdatedAt,
+	}
+}
+
+// CreateBrandResponses convierte una lista de marcas en su representación de lectura
+func CreateBrandResponses(brands []*entities.Brand) []*BrandResponse {
+	responses := make([]*BrandResponse, len(brands))
+	for i, brand := range brands {
+		responses[i] = CreateBrandResponse(brand)
+	}
+	return responses
+}
@@ -0,0 +1,131 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CarExpandOptions son los valores aceptados por el parámetro expand
+var CarExpandOptions = []string{"model", "brand", "owner", "trim"}
+
+type ModelSummary struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Category  string `json:"category"`
+	StartYear int    `json:"startYear"`
+	EndYear   int    `json:"endYear"`
+}
+
+type BrandSummary struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Country string `json:"country"`
+}
+
+type OwnerSummary struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// CarResponse es la representación de lectura de un auto; las relaciones solo se incluyen si fueron expandidas
+type CarResponse struct {
+	ID              string             `json:"id"`
+	ModelID         string             `json:"modelId"`
+	TrimID          *string            `json:"trimId"`
+	OwnerID         string             `json:"ownerId"`
+	Year            int                `json:"year"`
+	Color           string             `json:"color"`
+	VIN             string             `json:"vin"`
+	Status          entities.CarStatus `json:"status"`
+	OdometerFlagged bool               `json:"odometerFlagged"`
+	Model           *ModelSummary      `json:"model,omitempty"`
+	Brand           *BrandSummary      `json:"brand,omitempty"`
+	Owner           *OwnerSummary      `json:"owner,omitempty"`
+	Trim            *ModelTrimResponse `json:"trim,omitempty"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+}
+
+// ParseCarExpand interpreta una lista separada por comas de relaciones a expandir
+func ParseCarExpand(expand string) (repositories.CarRelations, error) {
+	var relations repositories.CarRelations
+	for _, value := range strings.Split(expand, ",") {
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "":
+		case "model":
+			relations.Model = true
+		case "brand":
+			relations.Brand = true
+		case "owner":
+			relations.Owner = true
+		case "trim":
+			relations.Trim = true
+		default:
+			return relations, fmt.Errorf("valor de expand no soportado: %s (valores permitidos: %s)", value, strings.Join(CarExpandOptions, ", "))
+		}
+	}
+	return relations, nil
+}
+
+// CreateCarResponse convierte un auto en su representación de lectura incluyendo las relaciones indicadas
+func CreateCarResponse(car *entities.Car, relations repositories.CarRelations) *CarResponse {
+	response := &CarResponse{
+		ID:              car.ID.String(),
+		ModelID:         car.ModelID.String(),
+		OwnerID:         car.OwnerID.String(),
+		Year:            car.Year,
+		Color:           car.Color,
+		VIN:             car.VIN,
+		Status:          car.Status,
+		OdometerFlagged: car.OdometerFlagged,
+		CreatedAt:       car.CreatedAt,
+		UpdatedAt:       car.UpdatedAt,
+	}
+	if car.TrimID != nil {
+		trimID := car.TrimID.String()
+		response.TrimID = &trimID
+	}
+
+	if relations.Model {
+		response.Model = &ModelSummary{
+			ID:        car.Model.ID.String(),
+			Name:      car.Model.Name,
+			Category:  car.Model.Category,
+			StartYear: car.Model.StartYear,
+			EndYear:   car.Model.EndYear,
+		}
+	}
+
+	if relations.Brand {
+		response.Brand = &BrandSummary{
+			ID:      car.Model.Brand.ID.String(),
+			Name:    car.Model.Brand.Name,
+			Country: car.Model.Brand.Country,
+		}
+	}
+
+	if relations.Owner {
+		response.Owner = &OwnerSummary{
+			ID:    car.Owner.ID.String(),
+			Name:  car.Owner.Name,
+			Email: car.Owner.Email,
+		}
+	}
+	if relations.Trim && car.Trim != nil {
+		response.Trim = CreateModelTrimResponse(car.Trim)
+	}
+	return response
+}
+
+// CreateCarResponses convierte una lista de autos en su representación de lectura
+func CreateCarResponses(cars []*entities.Car, relations repositories.CarRelations) []*CarResponse {
+	responses := make([]*CarResponse, len(cars))
+	for i, car := range cars {
+		responses[i] = CreateCarResponse(car, relations)
+	}
+	return responses
+}
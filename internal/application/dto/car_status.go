@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+// CarStatusTransitionResponse es la representación de lectura de un cambio de estado
+type CarStatusTransitionResponse struct {
+	ID        string             `json:"id"`
+	From      entities.CarStatus `json:"from,omitempty"`
+	To        entities.CarStatus `json:"to"`
+	Reason    string             `json:"reason"`
+	ChangedAt time.Time          `json:"changedAt"`
+}
+
+func CreateCarStatusTransitionResponse(transition *entities.CarStatusTransition) *CarStatusTransitionResponse {
+	return &CarStatusTransitionResponse{
+		ID:        transition.ID.String(),
+		From:      transition.FromStatus,
+		To:        transition.ToStatus,
+		Reason:    transition.Reason,
+		ChangedAt: transition.CreatedAt,
+	}
+}
@@ -0,0 +1,94 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"math"
+	"time"
+)
+
+type InspectionDefectResponse struct {
+	ID          string                  `json:"id"`
+	Description string                  `json:"description"`
+	Severity    entities.DefectSeverity `json:"severity"`
+}
+
+// InspectionResponse es la representación de lectura de una inspección técnica con sus defectos
+type InspectionResponse struct {
+	ID          string                      `json:"id"`
+	CarID       string                      `json:"carId"`
+	Date        time.Time                   `json:"date"`
+	Station     string                      `json:"station"`
+	Result      entities.InspectionResult   `json:"result"`
+	Defects     []*InspectionDefectResponse `json:"defects"`
+	Odometer    *int                        `json:"odometer"`
+	NextDueDate time.Time                   `json:"nextDueDate"`
+	Notes       string                      `json:"notes"`
+	CreatedAt   time.Time                   `json:"createdAt"`
+}
+
+// DueInspectionResponse es un auto con una inspección próxima a vencer o vencida
+type DueInspectionResponse struct {
+	CarID          string              `json:"carId"`
+	VIN            string              `json:"vin"`
+	Year           int                 `json:"year"`
+	Status         entities.CarStatus  `json:"status"`
+	Model          *ModelSummary       `json:"model"`
+	LastInspection *InspectionResponse `json:"lastInspection"` // null si el auto nunca fue inspeccionado
+	DueDate        time.Time           `json:"dueDate"`
+	Overdue        bool                `json:"overdue"`
+	DaysRemaining  int                 `json:"daysRemaining"` // Negativo si la inspección está vencida
+}
+
+// CreateInspectionDefectResponses convierte los defectos de una inspección
+func CreateInspectionDefectResponses(defects []entities.InspectionDefect) []*InspectionDefectResponse {
+	responses := make([]*InspectionDefectResponse, len(defects))
+	for i, defect := range defects {
+		responses[i] = &InspectionDefectResponse{
+			ID:          defect.ID.String(),
+			Description: defect.Description,
+			Severity:    defect.Severity,
+		}
+	}
+	return responses
+}
+
+// CreateInspectionResponse convierte una inspección en su representación de lectura
+func CreateInspectionResponse(inspection *entities.Inspection) *InspectionResponse {
+	return &InspectionResponse{
+		ID:          inspection.ID.String(),
+		CarID:       inspection.CarID.String(),
+		Date:        inspection.Date,
+		Station:     inspection.Station,
+		Result:      inspection.Result,
+		Defects:     CreateInspectionDefectResponses(inspection.Defects),
+		Odometer:    inspection.Odometer,
+		NextDueDate: inspection.NextDueDate,
+		Notes:       inspection.Notes,
+		CreatedAt:   inspection.CreatedAt,
+	}
+}
+
+// CreateDueInspectionResponse convierte un vencimiento de inspección calculado a la fecha indicada
+func CreateDueInspectionResponse(due *services.DueInspection, now time.Time) *DueInspectionResponse {
+	response := &DueInspectionResponse{
+		CarID:  due.Car.ID.String(),
+		VIN:    due.Car.VIN,
+		Year:   due.Car.Year,
+		Status: due.Car.Status,
+		Model: &ModelSummary{
+			ID:        due.Car.Model.ID.String(),
+			Name:      due.Car.Model.Name,
+			Category:  due.Car.Model.Category,
+			StartYear: due.Car.Model.StartYear,
+			EndYear:   due.Car.Model.EndYear,
+		},
+		DueDate:       due.DueDate,
+		Overdue:       due.DueDate.Before(now),
+		DaysRemaining: int(math.Floor(due.DueDate.Sub(now).Hours() / 24)),
+	}
+	if due.LastInspection != nil {
+		response.LastInspection = CreateInspectionResponse(due.LastInspection)
+	}
+	return response
+}
@@ -0,0 +1,87 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"math"
+	"time"
+)
+
+// InsurancePolicyResponse es la representación de lectura de una póliza de seguro
+type InsurancePolicyResponse struct {
+	ID           string                `json:"id"`
+	CarID        string                `json:"carId"`
+	OwnerID      string                `json:"ownerId"`
+	Insurer      string                `json:"insurer"`
+	PolicyNumber string                `json:"policyNumber"`
+	CoverageType entities.CoverageType `json:"coverageType"`
+	StartDate    time.Time             `json:"startDate"`
+	EndDate      time.Time             `json:"endDate"`
+	Premium      float64               `json:"premium"`
+	TerminatedAt *time.Time            `json:"terminatedAt"`
+	Active       bool                  `json:"active"`
+	Notes        string                `json:"notes"`
+	CreatedAt    time.Time             `json:"createdAt"`
+}
+
+// InsuranceAlertResponse es un auto sin seguro o con la cobertura próxima a vencer
+type InsuranceAlertResponse struct {
+	CarID         string                   `json:"carId"`
+	VIN           string                   `json:"vin"`
+	OwnerID       string                   `json:"ownerId"`
+	Status        entities.CarStatus       `json:"status"`
+	Reason        string                   `json:"reason"` // uninsured o expiring
+	Policy        *InsurancePolicyResponse `json:"policy"` // Póliza vigente; null si el auto no está asegurado
+	CoveredUntil  *time.Time               `json:"coveredUntil"`
+	DaysRemaining *int                     `json:"daysRemaining"`
+}
+
+const (
+	InsuranceAlertUninsured = "uninsured"
+	InsuranceAlertExpiring  = "expiring"
+)
+
+func CreateInsurancePolicyResponse(policy *entities.InsurancePolicy) *InsurancePolicyResponse {
+	return &InsurancePolicyResponse{
+		ID:           policy.ID.String(),
+		CarID:        policy.CarID.String(),
+		OwnerID:      policy.OwnerID.String(),
+		Insurer:      policy.Insurer,
+		PolicyNumber: policy.PolicyNumber,
+		CoverageType: policy.CoverageType,
+		StartDate:    policy.StartDate,
+		EndDate:      policy.EndDate,
+		Premium:      policy.Premium,
+		TerminatedAt: policy.TerminatedAt,
+		Active:       policy.IsActiveAt(time.Now()),
+		Notes:        policy.Notes,
+		CreatedAt:    policy.CreatedAt,
+	}
+}
+
+func CreateInsurancePolicyResponses(policies []*entities.InsurancePolicy) []*InsurancePolicyResponse {
+	responses := make([]*InsurancePolicyResponse, len(policies))
+	for i, policy := range policies {
+		responses[i] = CreateInsurancePolicyResponse(policy)
+	}
+	return responses
+}
+
+// CreateInsuranceAlertResponse convierte una alerta de seguro calculada a la fecha indicada
+func CreateInsuranceAlertResponse(alert *services.InsuranceAlert, now time.Time) *InsuranceAlertResponse {
+	response := &InsuranceAlertResponse{
+		CarID:   alert.Car.ID.String(),
+		VIN:     alert.Car.VIN,
+		OwnerID: alert.Car.OwnerID.String(),
+		Status:  alert.Car.Status,
+		Reason:  InsuranceAlertUninsured,
+	}
+	if alert.Policy != nil {
+		days := int(math.Floor(alert.CoveredUntil.Sub(now).Hours() / 24))
+		response.Reason = InsuranceAlertExpiring
+		response.Policy = CreateInsurancePolicyResponse(alert.Policy)
+		response.CoveredUntil = alert.CoveredUntil
+		response.DaysRemaining = &days
+	}
+	return response
+}
@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+// ModelResponse es la representación de lectura de un modelo
+type ModelResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	BrandID   string    `json:"brandId"`
+	StartYear int       `json:"startYear"`
+	EndYear   int       `json:"endYear"`
+	Category  string    `json:"category"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CreateModelResponse convierte un modelo en su representación de lectura
+func CreateModelResponse(model *entities.Model) *ModelResponse {
+	return &ModelResponse{
+		ID:        model.ID.String(),
+		Name:      model.Name,
+		BrandID:   model.BrandID.String(),
+		StartYear: model.StartYear,
+		EndYear:   model.EndYear,
+		Category:  model.Category,
+		Active:    model.Active,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
+
+// CreateModelResponses convierte una lista de modelos en su representación de lectura
+func CreateModelResponses(models []*entities.Model) []*ModelResponse {
+	responses := make([]*ModelResponse, len(models))
+	for i, model := range models {
+		responses[i] = CreateModelResponse(model)
+	}
+	return responses
+}
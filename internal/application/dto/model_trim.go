@@ -0,0 +1,137 @@
+package dto
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"fmt"
+	"time"
+)
+
+// ModelTrimResponse es la representación de lectura de una versión con su ficha técnica
+type ModelTrimResponse struct {
+	ID                 string                `json:"id"`
+	ModelID            string                `json:"modelId"`
+	Name               string                `json:"name"`
+	EngineDisplacement int                   `json:"engineDisplacement"`
+	Power              int                   `json:"power"`
+	FuelType           entities.FuelType     `json:"fuelType"`
+	Transmission       entities.Transmission `json:"transmission"`
+	Drivetrain         entities.Drivetrain   `json:"drivetrain"`
+	Doors              int                   `json:"doors"`
+	Seats              int                   `json:"seats"`
+	CurbWeight         int                   `json:"curbWeight"`
+	CO2Emissions       int                   `json:"co2Emissions"`
+	CreatedAt          time.Time             `json:"createdAt"`
+	UpdatedAt          time.Time             `json:"updatedAt"`
+}
+
+// SpecFilterRequest contiene los parámetros de búsqueda por ficha técnica compartidos por /cars y /models
+type SpecFilterRequest struct {
+	Fuel             string `form:"fuel"`
+	Transmission     string `form:"transmission"`
+	Drivetrain       string `form:"drivetrain"`
+	Doors            int    `form:"doors"`
+	Seats            int    `form:"seats"` // Cantidad mínima de plazas
+	PowerFrom        int    `form:"power_from"`
+	PowerTo          int    `form:"power_to"`
+	DisplacementFrom int    `form:"displacement_from"`
+	DisplacementTo   int    `form:"displacement_to"`
+	WeightTo         int    `form:"weight_to"`
+	CO2To            int    `form:"co2_to"`
+}
+
+// CreateModelTrimResponse convierte una versión en su representación de lectura
+func CreateModelTrimResponse(trim *entities.ModelTrim) *ModelTrimResponse {
+	return &ModelTrimResponse{
+		ID:                 trim.ID.String(),
+		ModelID:            trim.ModelID.String(),
+		Name:               trim.Name,
+		EngineDisplacement: trim.EngineDisplacement,
+		Power:              trim.Power,
+		FuelType:           trim.FuelType,
+		Transmission:       trim.Transmission,
+		Drivetrain:         trim.Drivetrain,
+		Doors:              trim.Doors,
+		Seats:              trim.Seats,
+		CurbWeight:         trim.CurbWeight,
+		CO2Emissions:       trim.CO2Emissions,
+		CreatedAt:          trim.CreatedAt,
+		UpdatedAt:          trim.UpdatedAt,
+	}
+}
+
+// ParseSpecFilter valida los parámetros de ficha técnica y construye el filtro del repositorio
+func ParseSpecFilter(request SpecFilterRequest) (repositories.SpecFilter, api.ValidationErrors) {
+	var errors api.ValidationErrors
+	if request.Fuel != "" && !entities.FuelType(request.Fuel).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "fuel",
+			Message: fmt.Sprintf("Combustible no soportado: %s", request.Fuel),
+		})
+	}
+
+	if request.Transmission != "" && !entities.Transmission(request.Transmission).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "transmission",
+			Message: fmt.Sprintf("Tipo de caja no soportado: %s", request.Transmission),
+		})
+	}
+
+	if request.Drivetrain != "" && !entities.Drivetrain(request.Drivetrain).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "drivetrain",
+			Message: fmt.Sprintf("Tipo de tracción no soportado: %s", request.Drivetrain),
+		})
+	}
+
+	nonNegative := []struct {
+		field string
+		value int
+	}{
+		{"doors", request.Doors},
+		{"seats", request.Seats},
+		{"power_from", request.PowerFrom},
+		{"power_to", request.PowerTo},
+		{"displacement_from", request.DisplacementFrom},
+		{"displacement_to", request.DisplacementTo},
+		{"weight_to", request.WeightTo},
+		{"co2_to", request.CO2To},
+	}
+	for _, param := range nonNegative {
+		if param.value < 0 {
+			errors = append(errors, &api.ValidationError{
+				Field:   param.field,
+				Message: "El valor no puede ser negativo",
+			})
+		}
+	}
+
+	if request.PowerFrom != 0 && request.PowerTo != 0 && request.PowerFrom > request.PowerTo {
+		errors = append(errors, &api.ValidationError{
+			Field:   "power_from",
+			Message: "La potencia mínima no puede ser mayor a la máxima",
+		})
+	}
+
+	if request.DisplacementFrom != 0 && request.DisplacementTo != 0 && request.DisplacementFrom > request.DisplacementTo {
+		errors = append(errors, &api.ValidationError{
+			Field:   "displacement_from",
+			Message: "La cilindrada mínima no puede ser mayor a la máxima",
+		})
+	}
+
+	return repositories.SpecFilter{
+		FuelType:         entities.FuelType(request.Fuel),
+		Transmission:     entities.Transmission(request.Transmission),
+		Drivetrain:       entities.Drivetrain(request.Drivetrain),
+		Doors:            request.Doors,
+		Seats:            request.Seats,
+		PowerFrom:        request.PowerFrom,
+		PowerTo:          request.PowerTo,
+		DisplacementFrom: request.DisplacementFrom,
+		DisplacementTo:   request.DisplacementTo,
+		CurbWeightTo:     request.WeightTo,
+		CO2To:            request.CO2To,
+	}, errors
+}
@@ -0,0 +1,59 @@
+package dto_test
+
+import (
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"reflect"
+	"testing"
+)
+
+func TestParseSpecFilterValidatesRanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    dto.SpecFilterRequest
+		fields []string
+	}{
+		{name: "sin filtros", req: dto.SpecFilterRequest{}},
+		{name: "rangos válidos", req: dto.SpecFilterRequest{PowerFrom: 100, PowerTo: 200, DisplacementFrom: 1400, DisplacementTo: 2000}},
+		{name: "rango de potencia con un mismo valor", req: dto.SpecFilterRequest{PowerFrom: 150, PowerTo: 150}},
+		{name: "solo potencia mínima", req: dto.SpecFilterRequest{PowerFrom: 500}},
+		{name: "solo cilindrada máxima", req: dto.SpecFilterRequest{DisplacementTo: 1000}},
+		{name: "potencia mínima mayor a la máxima", req: dto.SpecFilterRequest{PowerFrom: 200, PowerTo: 100}, fields: []string{"power_from"}},
+		{name: "cilindrada mínima mayor a la máxima", req: dto.SpecFilterRequest{DisplacementFrom: 2000, DisplacementTo: 1400}, fields: []string{"displacement_from"}},
+		{name: "ambos rangos invertidos", req: dto.SpecFilterRequest{PowerFrom: 200, PowerTo: 100, DisplacementFrom: 2000, DisplacementTo: 1400}, fields: []string{"power_from", "displacement_from"}},
+		{name: "valores negativos", req: dto.SpecFilterRequest{Doors: -1, PowerTo: -5, WeightTo: -1, CO2To: -1}, fields: []string{"doors", "power_to", "weight_to", "co2_to"}},
+		{name: "combustible, caja y tracción no soportados", req: dto.SpecFilterRequest{Fuel: "nuclear", Transmission: "pedales", Drivetrain: "6x6"}, fields: []string{"fuel", "transmission", "drivetrain"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errors := dto.ParseSpecFilter(tt.req)
+
+			var fields []string
+			for _, err := range errors {
+				fields = append(fields, err.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("campos con error = %v, se esperaba %v", fields, tt.fields)
+			}
+		})
+	}
+}
+
+func TestParseSpecFilterMapsRequest(t *testing.T) {
+	filter, errors := dto.ParseSpecFilter(dto.SpecFilterRequest{
+		Fuel: "diesel", Transmission: "automatic", Drivetrain: "fwd", Doors: 5, Seats: 5,
+		PowerFrom: 100, PowerTo: 200, DisplacementFrom: 1400, DisplacementTo: 2000, WeightTo: 1500, CO2To: 150,
+	})
+	if len(errors) > 0 {
+		t.Fatalf("errores inesperados: %v", errors)
+	}
+
+	expected := repositories.SpecFilter{
+		FuelType: entities.FuelTypeDiesel, Transmission: entities.TransmissionAutomatic, Drivetrain: entities.DrivetrainFWD,
+		Doors: 5, Seats: 5, PowerFrom: 100, PowerTo: 200, DisplacementFrom: 1400, DisplacementTo: 2000, CurbWeightTo: 1500, CO2To: 150,
+	}
+	if filter != expected {
+		t.Errorf("filtro = %+v, se esperaba %+v", filter, expected)
+	}
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"time"
+)
+
+type OdometerReadingResponse struct {
+	ID       string                   `json:"id"`
+	Date     time.Time                `json:"date"`
+	Value    int                      `json:"value"`
+	Source   entities.OdometerSource  `json:"source"`
+	SourceID *string                  `json:"sourceId,omitempty"`
+	Anomaly  entities.OdometerAnomaly `json:"anomaly,omitempty"`
+	Notes    string                   `json:"notes"`
+}
+
+// OdometerHistoryResponse contiene la serie cronológica de lecturas y el estado de anomalías del auto
+type OdometerHistoryResponse struct {
+	CarID     string                     `json:"carId"`
+	Flagged   bool                       `json:"flagged"`
+	Anomalies int                        `json:"anomalies"`
+	Latest    *OdometerReadingResponse   `json:"latest"` // Última lectura consistente
+	Readings  []*OdometerReadingResponse `json:"readings"`
+}
+
+func CreateOdometerReadingResponse(reading *entities.OdometerReading) *OdometerReadingResponse {
+	response := &OdometerReadingResponse{
+		ID:      reading.ID.String(),
+		Date:    reading.Date,
+		Value:   reading.Value,
+		Source:  reading.Source,
+		Anomaly: reading.Anomaly,
+		Notes:   reading.Notes,
+	}
+	if reading.SourceID != nil {
+		sourceID := reading.SourceID.String()
+		response.SourceID = &sourceID
+	}
+	return response
+}
+
+// CreateOdometerHistoryResponse convierte el historial de kilometraje de un auto
+func CreateOdometerHistoryResponse(history *services.OdometerHistory) *OdometerHistoryResponse {
+	response := &OdometerHistoryResponse{
+		CarID:    history.Car.ID.String(),
+		Flagged:  history.Car.OdometerFlagged,
+		Readings: make([]*OdometerReadingResponse, len(history.Readings)),
+	}
+
+	for i, reading := range history.Readings {
+		response.Readings[i] = CreateOdometerReadingResponse(reading)
+		if reading.Anomaly != entities.OdometerAnomalyNone {
+			response.Anomalies++
+			continue
+		}
+		response.Latest = response.Readings[i]
+	}
+	return response
+}
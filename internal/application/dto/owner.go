@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+// OwnerResponse es la representación de lectura de un propietario
+type OwnerResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Phone     string    `json:"phone"`
+	Address   string    `json:"address"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CreateOwnerResponse convierte un propietario en su representación de lectura
+func CreateOwnerResponse(owner *entities.Owner) *OwnerResponse {
+	return &OwnerResponse{
+		ID:        owner.ID.String(),
+		Name:      owner.Name,
+		Email:     owner.Email,
+		Phone:     owner.Phone,
+		Address:   owner.Address,
+		CreatedAt: owner.CreatedAt,
+		UpdatedAt: owner.UpdatedAt,
+	}
+}
+
+// CreateOwnerResponses convierte una lista de propietarios en su representación de lectura
+func CreateOwnerResponses(owners []*entities.Owner) []*OwnerResponse {
+	responses := make([]*OwnerResponse, len(owners))
+	for i, owner := range owners {
+		responses[i] = CreateOwnerResponse(owner)
+	}
+	return responses
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+// OwnershipRecordResponse es la representación de lectura de un período de titularidad
+type OwnershipRecordResponse struct {
+	ID        string        `json:"id"`
+	CarID     string        `json:"carId"`
+	Owner     *OwnerSummary `json:"owner"`
+	From      time.Time     `json:"from"`
+	To        *time.Time    `json:"to"`
+	Current   bool          `json:"current"`
+	Price     *float64      `json:"price,omitempty"`
+	Notes     string        `json:"notes"`
+	CreatedAt time.Time     `json:"createdAt"`
+}
+
+// CreateOwnershipRecordResponse convierte un registro de propiedad con su propietario cargado
+func CreateOwnershipRecordResponse(record *entities.OwnershipRecord) *OwnershipRecordResponse {
+	return &OwnershipRecordResponse{
+		ID:    record.ID.String(),
+		CarID: record.CarID.String(),
+		Owner: &OwnerSummary{
+			ID:    record.OwnerID.String(),
+			Name:  record.Owner.Name,
+			Email: record.Owner.Email,
+		},
+		From:      record.From,
+		To:        record.To,
+		Current:   record.IsCurrent(),
+		Price:     record.Price,
+		Notes:     record.Notes,
+		CreatedAt: record.CreatedAt,
+	}
+}
@@ -0,0 +1,101 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"time"
+)
+
+// RecallResponse es la representación de lectura de una campaña con el avance de las reparaciones
+type RecallResponse struct {
+	ID           string    `json:"id"`
+	ModelID      string    `json:"modelId"`
+	Campaign     string    `json:"campaign"`
+	Description  string    `json:"description"`
+	Component    string    `json:"component"`
+	Remedy       string    `json:"remedy"`
+	YearFrom     int       `json:"yearFrom"`
+	YearTo       int       `json:"yearTo"`
+	VINFrom      string    `json:"vinFrom"`
+	VINTo        string    `json:"vinTo"`
+	IssuedAt     time.Time `json:"issuedAt"`
+	AffectedCars int       `json:"affectedCars"`
+	RemediedCars int       `json:"remediedCars"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// CarRecallResponse es una campaña que alcanza a un vehículo con el estado de su reparación
+type CarRecallResponse struct {
+	RecallID    string                      `json:"recallId"`
+	Campaign    string                      `json:"campaign"`
+	Description string                      `json:"description"`
+	Component   string                      `json:"component"`
+	Remedy      string                      `json:"remedy"`
+	IssuedAt    time.Time                   `json:"issuedAt"`
+	Status      entities.RecallRemedyStatus `json:"status"`
+	RemediedAt  *time.Time                  `json:"remediedAt"`
+	Workshop    string                      `json:"workshop"`
+	Notes       string                      `json:"notes"`
+}
+
+// AffectedCarResponse es un vehículo alcanzado por una campaña con el estado de su reparación
+type AffectedCarResponse struct {
+	CarID      string                      `json:"carId"`
+	VIN        string                      `json:"vin"`
+	Year       int                         `json:"year"`
+	OwnerID    string                      `json:"ownerId"`
+	CarStatus  entities.CarStatus          `json:"carStatus"`
+	Status     entities.RecallRemedyStatus `json:"status"`
+	RemediedAt *time.Time                  `json:"remediedAt"`
+	Workshop   string                      `json:"workshop"`
+}
+
+func CreateRecallResponse(detail *services.RecallDetail) *RecallResponse {
+	recall := detail.Recall
+	return &RecallResponse{
+		ID:           recall.ID.String(),
+		ModelID:      recall.ModelID.String(),
+		Campaign:     recall.Campaign,
+		Description:  recall.Description,
+		Component:    recall.Component,
+		Remedy:       recall.Remedy,
+		YearFrom:     recall.YearFrom,
+		YearTo:       recall.YearTo,
+		VINFrom:      recall.VINFrom,
+		VINTo:        recall.VINTo,
+		IssuedAt:     recall.IssuedAt,
+		AffectedCars: detail.Affected,
+		RemediedCars: detail.Remedied,
+		CreatedAt:    recall.CreatedAt,
+	}
+}
+
+// CreateCarRecallResponse requiere la campaña cargada
+func CreateCarRecallResponse(carRecall *entities.CarRecall) *CarRecallResponse {
+	return &CarRecallResponse{
+		RecallID:    carRecall.RecallID.String(),
+		Campaign:    carRecall.Recall.Campaign,
+		Description: carRecall.Recall.Description,
+		Component:   carRecall.Recall.Component,
+		Remedy:      carRecall.Recall.Remedy,
+		IssuedAt:    carRecall.Recall.IssuedAt,
+		Status:      carRecall.RemedyStatus(),
+		RemediedAt:  carRecall.RemediedAt,
+		Workshop:    carRecall.Workshop,
+		Notes:       carRecall.Notes,
+	}
+}
+
+// CreateAffectedCarResponse requiere el auto cargado
+func CreateAffectedCarResponse(carRecall *entities.CarRecall) *AffectedCarResponse {
+	return &AffectedCarResponse{
+		CarID:      carRecall.CarID.String(),
+		VIN:        carRecall.Car.VIN,
+		Year:       carRecall.Car.Year,
+		OwnerID:    carRecall.Car.OwnerID.String(),
+		CarStatus:  carRecall.Car.Status,
+		Status:     carRecall.RemedyStatus(),
+		RemediedAt: carRecall.RemediedAt,
+		Workshop:   carRecall.Workshop,
+	}
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+// RegistrationResponse es la representación de lectura de una patente
+type RegistrationResponse struct {
+	ID          string     `json:"id"`
+	CarID       string     `json:"carId"`
+	Plate       string     `json:"plate"`
+	Country     string     `json:"country"`
+	Region      string     `json:"region"`
+	Format      string     `json:"format"`
+	IssuedAt    time.Time  `json:"issuedAt"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+	CancelledAt *time.Time `json:"cancelledAt"`
+	Active      bool       `json:"active"`
+	Expired     bool       `json:"expired"`
+}
+
+func CreateRegistrationResponse(registration *entities.Registration) *RegistrationResponse {
+	return &RegistrationResponse{
+		ID:          registration.ID.String(),
+		CarID:       registration.CarID.String(),
+		Plate:       registration.Plate,
+		Country:     registration.Country,
+		Region:      registration.Region,
+		Format:      registration.Format,
+		IssuedAt:    registration.IssuedAt,
+		ExpiresAt:   registration.ExpiresAt,
+		CancelledAt: registration.CancelledAt,
+		Active:      registration.IsActive(),
+		Expired:     registration.IsExpired(time.Now()),
+	}
+}
@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+type ServiceLineItemResponse struct {
+	ID          string  `json:"id"`
+	Description string  `json:"description"`
+	Quantity    float64 `json:"quantity"`
+	UnitPrice   float64 `json:"unitPrice"`
+	Total       float64 `json:"total"`
+}
+
+// ServiceRecordResponse es la representación de lectura de un servicio con sus ítems
+type ServiceRecordResponse struct {
+	ID              string                     `json:"id"`
+	CarID           string                     `json:"carId"`
+	Date            time.Time                  `json:"date"`
+	Odometer        int                        `json:"odometer"`
+	Workshop        string                     `json:"workshop"`
+	Type            entities.ServiceType       `json:"type"`
+	ComponentGroup  entities.ComponentGroup    `json:"componentGroup,omitempty"`
+	WarrantyCovered bool                       `json:"warrantyCovered"`
+	LineItems       []*ServiceLineItemResponse `json:"lineItems"`
+	Cost            float64                    `json:"cost"`
+	Notes           string                     `json:"notes"`
+	CreatedAt       time.Time                  `json:"createdAt"`
+	UpdatedAt       time.Time                  `json:"updatedAt"`
+}
+
+// CreateServiceLineItemResponses convierte los ítems de un servicio
+func CreateServiceLineItemResponses(items []entities.ServiceLineItem) []*ServiceLineItemResponse {
+	responses := make([]*ServiceLineItemResponse, len(items))
+	for i, item := range items {
+		responses[i] = &ServiceLineItemResponse{
+			ID:          item.ID.String(),
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Total:       item.Total(),
+		}
+	}
+	return responses
+}
+
+// CreateServiceRecordResponse convierte un servicio en su representación de lectura
+func CreateServiceRecordResponse(record *entities.ServiceRecord) *ServiceRecordResponse {
+	return &ServiceRecordResponse{
+		ID:              record.ID.String(),
+		CarID:           record.CarID.String(),
+		Date:            record.Date,
+		Odometer:        record.Odometer,
+		Workshop:        record.Workshop,
+		Type:            record.Type,
+		ComponentGroup:  record.ComponentGroup,
+		WarrantyCovered: record.WarrantyCovered,
+		LineItems:       CreateServiceLineItemResponses(record.LineItems),
+		Cost:            record.Cost,
+		Notes:           record.Notes,
+		CreatedAt:       record.CreatedAt,
+		UpdatedAt:       record.UpdatedAt,
+	}
+}
@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"time"
+)
+
+// StolenReportResponse es la representación de lectura de una denuncia de robo
+type StolenReportResponse struct {
+	ID              string     `json:"id"`
+	CarID           *string    `json:"carId"`
+	VIN             string     `json:"vin"`
+	ReportDate      time.Time  `json:"reportDate"`
+	PoliceReference string     `json:"policeReference"`
+	RecoveredDate   *time.Time `json:"recoveredDate"`
+	Active          bool       `json:"active"`
+	Notes           string     `json:"notes"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+// VinWatchlistResponse indica si un VIN tiene una denuncia de robo vigente
+type VinWatchlistResponse struct {
+	VIN     string                `json:"vin"`
+	Flagged bool                  `json:"flagged"`
+	Report  *StolenReportResponse `json:"report,omitempty"`
+}
+
+func CreateStolenReportResponse(report *entities.StolenReport) *StolenReportResponse {
+	response := &StolenReportResponse{
+		ID:              report.ID.String(),
+		VIN:             report.VIN,
+		ReportDate:      report.ReportDate,
+		PoliceReference: report.PoliceReference,
+		RecoveredDate:   report.RecoveredDate,
+		Active:          report.IsActive(),
+		Notes:           report.Notes,
+		CreatedAt:       report.CreatedAt,
+		UpdatedAt:       report.UpdatedAt,
+	}
+	if report.CarID != nil {
+		carID := report.CarID.String()
+		response.CarID = &carID
+	}
+	return response
+}
@@ -0,0 +1,120 @@
+package dto
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"car-service/internal/domain/warranty"
+	"time"
+)
+
+type WarrantyCoverageResponse struct {
+	ID             string                  `json:"id"`
+	ComponentGroup entities.ComponentGroup `json:"componentGroup"`
+	Months         int                     `json:"months"`
+	OdometerLimit  int                     `json:"odometerLimit"`
+}
+
+// WarrantyTemplateResponse es la representación de lectura de una plantilla de garantía
+type WarrantyTemplateResponse struct {
+	ID        string                      `json:"id"`
+	BrandID   string                      `json:"brandId"`
+	BrandName string                      `json:"brandName"`
+	ModelID   *string                     `json:"modelId"`
+	ModelName string                      `json:"modelName,omitempty"`
+	Name      string                      `json:"name"`
+	Coverages []*WarrantyCoverageResponse `json:"coverages"`
+	CreatedAt time.Time                   `json:"createdAt"`
+}
+
+// CoverageStatusResponse es el estado de una cobertura para un vehículo
+type CoverageStatusResponse struct {
+	ComponentGroup    entities.ComponentGroup `json:"componentGroup"`
+	Months            int                     `json:"months"`
+	OdometerLimit     int                     `json:"odometerLimit"`
+	ExpiresAt         time.Time               `json:"expiresAt"`
+	Active            bool                    `json:"active"`
+	ExpiredBy         warranty.ExpiredBy      `json:"expiredBy,omitempty"`
+	RemainingDays     int                     `json:"remainingDays"`
+	RemainingDistance *int                    `json:"remainingDistance"`
+}
+
+// CarWarrantyResponse es la cobertura de garantía restante de un vehículo
+type CarWarrantyResponse struct {
+	CarID           string                    `json:"carId"`
+	TemplateID      *string                   `json:"templateId"`
+	TemplateName    string                    `json:"templateName,omitempty"`
+	StartDate       time.Time                 `json:"startDate"`
+	FirstRegistered bool                      `json:"firstRegistered"`
+	Odometer        *int                      `json:"odometer"`
+	OdometerDate    *time.Time                `json:"odometerDate"`
+	Active          bool                      `json:"active"` // Si alguna cobertura sigue vigente
+	Coverages       []*CoverageStatusResponse `json:"coverages"`
+}
+
+// CreateWarrantyCoverageResponses convierte las coberturas de una plantilla
+func CreateWarrantyCoverageResponses(coverages []entities.WarrantyCoverage) []*WarrantyCoverageResponse {
+	responses := make([]*WarrantyCoverageResponse, len(coverages))
+	for i, coverage := range coverages {
+		responses[i] = &WarrantyCoverageResponse{
+			ID:             coverage.ID.String(),
+			ComponentGroup: coverage.ComponentGroup,
+			Months:         coverage.Months,
+			OdometerLimit:  coverage.OdometerLimit,
+		}
+	}
+	return responses
+}
+
+// CreateWarrantyTemplateResponse convierte una plantilla con su marca y su modelo cargados
+func CreateWarrantyTemplateResponse(template *entities.WarrantyTemplate) *WarrantyTemplateResponse {
+	response := &WarrantyTemplateResponse{
+		ID:        template.ID.String(),
+		BrandID:   template.BrandID.String(),
+		BrandName: template.Brand.Name,
+		Name:      template.Name,
+		Coverages: CreateWarrantyCoverageResponses(template.Coverages),
+		CreatedAt: template.CreatedAt,
+	}
+	if template.ModelID != nil {
+		modelID := template.ModelID.String()
+		response.ModelID = &modelID
+	}
+	if template.Model != nil {
+		response.ModelName = template.Model.Name
+	}
+	return response
+}
+
+// CreateCarWarrantyResponse convierte el estado de la garantía de un vehículo
+func CreateCarWarrantyResponse(carWarranty *services.CarWarranty) *CarWarrantyResponse {
+	response := &CarWarrantyResponse{
+		CarID:           carWarranty.Car.ID.String(),
+		StartDate:       carWarranty.StartDate,
+		FirstRegistered: carWarranty.FirstRegistered,
+		Coverages:       make([]*CoverageStatusResponse, len(carWarranty.Coverages)),
+	}
+	if carWarranty.Template != nil {
+		templateID := carWarranty.Template.ID.String()
+		response.TemplateID = &templateID
+		response.TemplateName = carWarranty.Template.Name
+	}
+	if carWarranty.Odometer != nil {
+		response.Odometer = &carWarranty.Odometer.Value
+		response.OdometerDate = &carWarranty.Odometer.Date
+	}
+
+	for i, status := range carWarranty.Coverages {
+		response.Coverages[i] = &CoverageStatusResponse{
+			ComponentGroup:    status.Coverage.ComponentGroup,
+			Months:            status.Coverage.Months,
+			OdometerLimit:     status.Coverage.OdometerLimit,
+			ExpiresAt:         status.ExpiresAt,
+			Active:            status.Active,
+			ExpiredBy:         status.ExpiredBy,
+			RemainingDays:     status.RemainingDays,
+			RemainingDistance: status.RemainingDistance,
+		}
+		response.Active = response.Active || status.Active
+	}
+	return response
+}
@@ -0,0 +1,41 @@
+package check_vin_watchlist
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"car-service/internal/domain/vin"
+	"context"
+)
+
+const Name = "CheckVinWatchlist"
+
+type CheckVinWatchlistRequest struct {
+	Vin string `uri:"vin"`
+}
+
+type CheckVinWatchlistQuery struct {
+	service services.StolenReportService
+}
+
+func NewCheckVinWatchlistQuery(service services.StolenReportService) *CheckVinWatchlistQuery {
+	return &CheckVinWatchlistQuery{service: service}
+}
+
+func (q *CheckVinWatchlistQuery) Execute(request api.QueryRequest[CheckVinWatchlistRequest], ctx context.Context) (*dto.VinWatchlistResponse, error) {
+	if err := vin.Validate(request.Data.Vin); err != nil {
+		return nil, api.ValidationErrors{{Field: "vin", Message: err.Error()}}
+	}
+
+	normalized := vin.Normalize(request.Data.Vin)
+	report, err := q.service.CheckVIN(ctx, normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.VinWatchlistResponse{VIN: normalized, Flagged: report != nil}
+	if report != nil {
+		response.Report = dto.CreateStolenReportResponse(report)
+	}
+	return response, nil
+}
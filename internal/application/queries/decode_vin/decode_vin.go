@@ -0,0 +1,27 @@
+package decode_vin
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/domain/vin"
+	"context"
+)
+
+const Name = "DecodeVin"
+
+type DecodeVinRequest struct {
+	Vin string `uri:"vin"`
+}
+
+type DecodeVinQuery struct{}
+
+func NewDecodeVinQuery() *DecodeVinQuery {
+	return &DecodeVinQuery{}
+}
+
+func (q *DecodeVinQuery) Execute(request api.QueryRequest[DecodeVinRequest], ctx context.Context) (*vin.Decoded, error) {
+	decoded, err := vin.Decode(request.Data.Vin)
+	if err != nil {
+		return nil, api.ValidationErrors{{Field: "vin", Message: err.Error()}}
+	}
+	return decoded, nil
+}
@@ -0,0 +1,32 @@
+package get_brand
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetBrand"
+
+type GetBrandRequest struct {
+	ID uuid.UUID `uri:"id"`
+}
+
+type GetBrandQuery struct {
+	service services.BrandService
+}
+
+func NewGetBrandQuery(service services.BrandService) *GetBrandQuery {
+	return &GetBrandQuery{service: service}
+}
+
+func (q *GetBrandQuery) Execute(request api.QueryRequest[GetBrandRequest], ctx context.Context) (*dto.BrandResponse, error) {
+	brand, err := q.service.GetBrand(ctx, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateBrandResponse(brand), nil
+}
@@ -0,0 +1,32 @@
+package get_brand_models
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetBrandModels"
+
+type GetBrandModelsRequest struct {
+	ID uuid.UUID `uri:"id"`
+}
+
+type GetBrandModelsQuery struct {
+	service services.ModelService
+}
+
+func NewGetBrandModelsQuery(service services.ModelService) *GetBrandModelsQuery {
+	return &GetBrandModelsQuery{service: service}
+}
+
+func (q *GetBrandModelsQuery) Execute(request api.QueryRequest[GetBrandModelsRequest], ctx context.Context) ([]*dto.ModelResponse, error) {
+	models, err := q.service.GetBrandModels(ctx, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateModelResponses(models), nil
+}
@@ -0,0 +1,28 @@
+package get_brands
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+)
+
+const Name = "GetBrands"
+
+type GetBrandsRequest struct{}
+
+type GetBrandsQuery struct {
+	service services.BrandService
+}
+
+func NewGetBrandsQuery(service services.BrandService) *GetBrandsQuery {
+	return &GetBrandsQuery{service: service}
+}
+
+func (q *GetBrandsQuery) Execute(request api.QueryRequest[GetBrandsRequest], ctx context.Context) ([]*dto.BrandResponse, error) {
+	brands, err := q.service.GetBrands(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateBrandResponses(brands), nil
+}
@@ -0,0 +1,38 @@
+package get_car
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetCar"
+
+type GetCarRequest struct {
+	ID     uuid.UUID `uri:"id"`
+	Expand string    `form:"expand"` // Relaciones a incluir separadas por coma: model, brand, owner, trim
+}
+
+type GetCarQuery struct {
+	service services.CarService
+}
+
+func NewGetCarQuery(service services.CarService) *GetCarQuery {
+	return &GetCarQuery{service: service}
+}
+
+func (q *GetCarQuery) Execute(request api.QueryRequest[GetCarRequest], ctx context.Context) (*dto.CarResponse, error) {
+	relations, err := dto.ParseCarExpand(request.Data.Expand)
+	if err != nil {
+		return nil, api.ValidationErrors{{Field: "expand", Message: err.Error()}}
+	}
+
+	car, err := q.service.GetCarDetail(ctx, request.Data.ID, relations)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateCarResponse(car, relations), nil
+}
@@ -0,0 +1,37 @@
+package get_car_inspections
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetCarInspections"
+
+type GetCarInspectionsRequest struct {
+	CarID uuid.UUID `uri:"id"`
+}
+
+type GetCarInspectionsQuery struct {
+	service services.InspectionService
+}
+
+func NewGetCarInspectionsQuery(service services.InspectionService) *GetCarInspectionsQuery {
+	return &GetCarInspectionsQuery{service: service}
+}
+
+func (q *GetCarInspectionsQuery) Execute(request api.QueryRequest[GetCarInspectionsRequest], ctx context.Context) ([]*dto.InspectionResponse, error) {
+	inspections, err := q.service.GetCarInspections(ctx, request.Data.CarID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.InspectionResponse, len(inspections))
+	for i, inspection := range inspections {
+		responses[i] = dto.CreateInspectionResponse(inspection)
+	}
+	return responses, nil
+}
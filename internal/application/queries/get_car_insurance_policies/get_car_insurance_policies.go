@@ -0,0 +1,32 @@
+package get_car_insurance_policies
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetCarInsurancePolicies"
+
+type GetCarInsurancePoliciesRequest struct {
+	CarID uuid.UUID `uri:"id"`
+}
+
+type GetCarInsurancePoliciesQuery struct {
+	service services.InsurancePolicyService
+}
+
+func NewGetCarInsurancePoliciesQuery(service services.InsurancePolicyService) *GetCarInsurancePoliciesQuery {
+	return &GetCarInsurancePoliciesQuery{service: service}
+}
+
+func (q *GetCarInsurancePoliciesQuery) Execute(request api.QueryRequest[GetCarInsurancePoliciesRequest], ctx context.Context) ([]*dto.InsurancePolicyResponse, error) {
+	policies, err := q.service.GetCarPolicies(ctx, request.Data.CarID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateInsurancePolicyResponses(policies), nil
+}
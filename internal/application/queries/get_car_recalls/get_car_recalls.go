@@ -0,0 +1,52 @@
+package get_car_recalls
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetCarRecalls"
+
+type GetCarRecallsRequest struct {
+	CarID  uuid.UUID `uri:"id"`
+	Status string    `form:"status"` // open (por defecto), remedied o all
+}
+
+type GetCarRecallsQuery struct {
+	service services.RecallService
+}
+
+func NewGetCarRecallsQuery(service services.RecallService) *GetCarRecallsQuery {
+	return &GetCarRecallsQuery{service: service}
+}
+
+func (q *GetCarRecallsQuery) Execute(request api.QueryRequest[GetCarRecallsRequest], ctx context.Context) ([]*dto.CarRecallResponse, error) {
+	var status entities.RecallRemedyStatus
+	switch request.Data.Status {
+	case "":
+		status = entities.RecallRemedyOpen
+	case "all":
+	default:
+		status = entities.RecallRemedyStatus(request.Data.Status)
+		if !status.IsValid() {
+			return nil, api.ValidationErrors{{Field: "status", Message: fmt.Sprintf("Estado de reparación no soportado: %s", status)}}
+		}
+	}
+
+	carRecalls, err := q.service.GetCarRecalls(ctx, request.Data.CarID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.CarRecallResponse, len(carRecalls))
+	for i, carRecall := range carRecalls {
+		responses[i] = dto.CreateCarRecallResponse(carRecall)
+	}
+	return responses, nil
+}
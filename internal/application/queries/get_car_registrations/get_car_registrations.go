@@ -0,0 +1,37 @@
+package get_car_registrations
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetCarRegistrations"
+
+type GetCarRegistrationsRequest struct {
+	CarID uuid.UUID `uri:"id"`
+}
+
+type GetCarRegistrationsQuery struct {
+	service services.RegistrationService
+}
+
+func NewGetCarRegistrationsQuery(service services.RegistrationService) *GetCarRegistrationsQuery {
+	return &GetCarRegistrationsQuery{service: service}
+}
+
+func (q *GetCarRegistrationsQuery) Execute(request api.QueryRequest[GetCarRegistrationsRequest], ctx context.Context) ([]*dto.RegistrationResponse, error) {
+	registrations, err := q.service.GetCarRegistrations(ctx, request.Data.CarID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.RegistrationResponse, len(registrations))
+	for i, registration := range registrations {
+		responses[i] = dto.CreateRegistrationResponse(registration)
+	}
+	return responses, nil
+}
@@ -0,0 +1,37 @@
+package get_car_status_history
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetCarStatusHistory"
+
+type GetCarStatusHistoryRequest struct {
+	ID uuid.UUID `uri:"id"`
+}
+
+type GetCarStatusHistoryQuery struct {
+	service services.CarService
+}
+
+func NewGetCarStatusHistoryQuery(service services.CarService) *GetCarStatusHistoryQuery {
+	return &GetCarStatusHistoryQuery{service: service}
+}
+
+func (q *GetCarStatusHistoryQuery) Execute(request api.QueryRequest[GetCarStatusHistoryRequest], ctx context.Context) ([]*dto.CarStatusTransitionResponse, error) {
+	transitions, err := q.service.GetStatusHistory(ctx, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.CarStatusTransitionResponse, len(transitions))
+	for i, transition := range transitions {
+		responses[i] = dto.CreateCarStatusTransitionResponse(transition)
+	}
+	return responses, nil
+}
@@ -0,0 +1,37 @@
+package get_car_stolen_reports
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetCarStolenReports"
+
+type GetCarStolenReportsRequest struct {
+	CarID uuid.UUID `uri:"id"`
+}
+
+type GetCarStolenReportsQuery struct {
+	service services.StolenReportService
+}
+
+func NewGetCarStolenReportsQuery(service services.StolenReportService) *GetCarStolenReportsQuery {
+	return &GetCarStolenReportsQuery{service: service}
+}
+
+func (q *GetCarStolenReportsQuery) Execute(request api.QueryRequest[GetCarStolenReportsRequest], ctx context.Context) ([]*dto.StolenReportResponse, error) {
+	reports, err := q.service.GetCarStolenReports(ctx, request.Data.CarID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.StolenReportResponse, len(reports))
+	for i, report := range reports {
+		responses[i] = dto.CreateStolenReportResponse(report)
+	}
+	return responses, nil
+}
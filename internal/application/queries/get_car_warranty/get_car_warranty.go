@@ -0,0 +1,33 @@
+package get_car_warranty
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetCarWarranty"
+
+type GetCarWarrantyRequest struct {
+	CarID uuid.UUID `uri:"id"`
+}
+
+type GetCarWarrantyQuery struct {
+	service services.WarrantyService
+}
+
+func NewGetCarWarrantyQuery(service services.WarrantyService) *GetCarWarrantyQuery {
+	return &GetCarWarrantyQuery{service: service}
+}
+
+func (q *GetCarWarrantyQuery) Execute(request api.QueryRequest[GetCarWarrantyRequest], ctx context.Context) (*dto.CarWarrantyResponse, error) {
+	carWarranty, err := q.service.GetCarWarranty(ctx, request.Data.CarID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateCarWarrantyResponse(carWarranty), nil
+}
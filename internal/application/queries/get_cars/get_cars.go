@@ -2,12 +2,39 @@ package get_cars
 
 import (
 	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/pagination"
+	"car-service/internal/domain/plate"
+	"car-service/internal/domain/repositories"
 	"car-service/internal/domain/services"
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
 )
 
 const Name = "GetCars"
 
+type GetCarsRequest struct {
+	BrandID  uuid.UUID `form:"brandId"`
+	ModelID  uuid.UUID `form:"modelId"`
+	OwnerID  uuid.UUID `form:"ownerId"`
+	YearFrom int       `form:"year_from"`
+	YearTo   int       `form:"year_to"`
+	Color    string    `form:"color"`
+	Status   string    `form:"status"`
+	Active   *bool     `form:"active"` // Con true, vehículos en estados no finales; con false, dados de baja o exportados
+	Plate    string    `form:"plate"`
+	Sort     string    `form:"sort"` // Campo de ordenamiento; el prefijo "-" indica orden descendente
+	Page     *int      `form:"page"`
+	PageSize *int      `form:"pageSize"`
+	Expand   string    `form:"expand"` // Relaciones a incluir separadas por coma: model, brand, owner, trim
+	dto.SpecFilterRequest
+}
+
 type GetCarsQuery struct {
 	service services.CarService
 }
@@ -16,6 +43,96 @@ func NewGetCarsQuery(service services.CarService) *GetCarsQuery {
 	return &GetCarsQuery{service: service}
 }
 
-func (q *GetCarsQuery) Execute(request api.QueryRequest[any], ctx context.Context) (any, error) {
-	return q.service.GetCars(ctx)
+func (q *GetCarsQuery) Execute(request api.QueryRequest[GetCarsRequest], ctx context.Context) (*pagination.Page[*dto.CarResponse], error) {
+	filter, validationErrors := buildFilter(request.Data)
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	cars, err := q.service.GetCars(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return pagination.Map(cars, func(car *entities.Car) *dto.CarResponse {
+		return dto.CreateCarResponse(car, filter.Relations)
+	}), nil
+}
+
+func buildFilter(request GetCarsRequest) (repositories.CarFilter, api.ValidationErrors) {
+	var errors api.ValidationErrors
+	if request.YearFrom != 0 && request.YearTo != 0 && request.YearFrom > request.YearTo {
+		errors = append(errors, &api.ValidationError{
+			Field:   "year_from",
+			Message: "El año inicial no puede ser mayor al año final",
+		})
+	}
+
+	page := pagination.Request{}
+	if request.Page != nil {
+		page.Page = *request.Page
+	}
+	if request.PageSize != nil {
+		page.PageSize = *request.PageSize
+	}
+
+	if request.Page != nil && page.Page < 1 {
+		errors = append(errors, &api.ValidationError{
+			Field:   "page",
+			Message: "La página debe ser mayor a 0",
+		})
+	}
+
+	if request.PageSize != nil && (page.PageSize < 1 || page.PageSize > pagination.MaxPageSize) {
+		errors = append(errors, &api.ValidationError{
+			Field:   "pageSize",
+			Message: fmt.Sprintf("El tamaño de página debe estar entre 1 y %d", pagination.MaxPageSize),
+		})
+	}
+
+	sortField, sortDesc := "createdAt", true
+	if request.Sort != "" {
+		sortField = strings.TrimPrefix(request.Sort, "-")
+		sortDesc = strings.HasPrefix(request.Sort, "-")
+		if !slices.Contains(repositories.CarSortFields, sortField) {
+			errors = append(errors, &api.ValidationError{
+				Field:   "sort",
+				Message: fmt.Sprintf("El campo de ordenamiento debe ser uno de: %s", strings.Join(repositories.CarSortFields, ", ")),
+			})
+		}
+	}
+
+	if request.Status != "" && !entities.CarStatus(request.Status).IsValid() {
+		errors = append(errors, &api.ValidationError{
+			Field:   "status",
+			Message: fmt.Sprintf("Estado no soportado: %s", request.Status),
+		})
+	}
+
+	specs, specErrors := dto.ParseSpecFilter(request.SpecFilterRequest)
+	errors = append(errors, specErrors...)
+
+	relations, err := dto.ParseCarExpand(request.Expand)
+	if err != nil {
+		errors = append(errors, &api.ValidationError{
+			Field:   "expand",
+			Message: err.Error(),
+		})
+	}
+
+	return repositories.CarFilter{
+		BrandID:   request.BrandID,
+		ModelID:   request.ModelID,
+		OwnerID:   request.OwnerID,
+		YearFrom:  request.YearFrom,
+		YearTo:    request.YearTo,
+		Color:     request.Color,
+		Status:    entities.CarStatus(request.Status),
+		Active:    request.Active,
+		Plate:     plate.Normalize(request.Plate),
+		Specs:     specs,
+		SortField: sortField,
+		SortDesc:  sortDesc,
+		Page:      page,
+		Relations: relations,
+	}, errors
 }
@@ -0,0 +1,59 @@
+package get_cars
+
+import (
+	"car-service/internal/domain/pagination"
+	"reflect"
+	"testing"
+)
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+func TestBuildFilterValidatesPagination(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    GetCarsRequest
+		page   pagination.Request
+		fields []string
+	}{
+		{name: "sin paginación usa los valores por defecto", req: GetCarsRequest{}},
+		{name: "página y tamaño válidos", req: GetCarsRequest{Page: intPtr(2), PageSize: intPtr(50)}, page: pagination.Request{Page: 2, PageSize: 50}},
+		{name: "página 0", req: GetCarsRequest{Page: intPtr(0)}, fields: []string{"page"}},
+		{name: "página negativa", req: GetCarsRequest{Page: intPtr(-1)}, fields: []string{"page"}},
+		{name: "tamaño 0", req: GetCarsRequest{PageSize: intPtr(0)}, fields: []string{"pageSize"}},
+		{name: "tamaño mayor al máximo", req: GetCarsRequest{PageSize: intPtr(pagination.MaxPageSize + 1)}, fields: []string{"pageSize"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, errors := buildFilter(tt.req)
+
+			var fields []string
+			for _, err := range errors {
+				fields = append(fields, err.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Fatalf("campos con error = %v, se esperaba %v", fields, tt.fields)
+			}
+			if tt.fields == nil && filter.Page != tt.page {
+				t.Errorf("página = %+v, se esperaba %+v", filter.Page, tt.page)
+			}
+		})
+	}
+}
+
+func TestBuildFilterKeepsActive(t *testing.T) {
+	for _, active := range []*bool{nil, boolPtr(true), boolPtr(false)} {
+		filter, errors := buildFilter(GetCarsRequest{Active: active})
+		if len(errors) > 0 {
+			t.Fatalf("errores inesperados: %v", errors)
+		}
+		if !reflect.DeepEqual(filter.Active, active) {
+			t.Errorf("active = %v, se esperaba %v", filter.Active, active)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package get_due_inspections
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/period"
+	"car-service/internal/domain/services"
+	"context"
+	"time"
+)
+
+const Name = "GetDueInspections"
+
+type GetDueInspectionsRequest struct {
+	Within string `form:"within"` // Período a futuro: días ("30d"), semanas ("4w") o una duración ("72h"); por defecto 30 días
+}
+
+type GetDueInspectionsQuery struct {
+	service services.InspectionService
+}
+
+func NewGetDueInspectionsQuery(service services.InspectionService) *GetDueInspectionsQuery {
+	return &GetDueInspectionsQuery{service: service}
+}
+
+func (q *GetDueInspectionsQuery) Execute(request api.QueryRequest[GetDueInspectionsRequest], ctx context.Context) ([]*dto.DueInspectionResponse, error) {
+	window, err := period.ParseWindow(request.Data.Within)
+	if err != nil {
+		return nil, api.ValidationErrors{{Field: "within", Message: err.Error()}}
+	}
+
+	now := time.Now()
+	due, err := q.service.GetDueInspections(ctx, now.Add(window))
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.DueInspectionResponse, len(due))
+	for i, inspection := range due {
+		responses[i] = dto.CreateDueInspectionResponse(inspection, now)
+	}
+	return responses, nil
+}
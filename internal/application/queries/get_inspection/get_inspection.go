@@ -0,0 +1,33 @@
+package get_inspection
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetInspection"
+
+type GetInspectionRequest struct {
+	CarID uuid.UUID `uri:"id"`
+	ID    uuid.UUID `uri:"inspectionId"`
+}
+
+type GetInspectionQuery struct {
+	service services.InspectionService
+}
+
+func NewGetInspectionQuery(service services.InspectionService) *GetInspectionQuery {
+	return &GetInspectionQuery{service: service}
+}
+
+func (q *GetInspectionQuery) Execute(request api.QueryRequest[GetInspectionRequest], ctx context.Context) (*dto.InspectionResponse, error) {
+	inspection, err := q.service.GetInspection(ctx, request.Data.CarID, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateInspectionResponse(inspection), nil
+}
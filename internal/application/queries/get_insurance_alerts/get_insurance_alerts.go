@@ -0,0 +1,43 @@
+package get_insurance_alerts
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/period"
+	"car-service/internal/domain/services"
+	"context"
+	"time"
+)
+
+const Name = "GetInsuranceAlerts"
+
+type GetInsuranceAlertsRequest struct {
+	Within string `form:"within"` // Período a futuro: días ("30d"), semanas ("4w") o una duración ("72h"); por defecto 30 días
+}
+
+type GetInsuranceAlertsQuery struct {
+	service services.InsurancePolicyService
+}
+
+func NewGetInsuranceAlertsQuery(service services.InsurancePolicyService) *GetInsuranceAlertsQuery {
+	return &GetInsuranceAlertsQuery{service: service}
+}
+
+func (q *GetInsuranceAlertsQuery) Execute(request api.QueryRequest[GetInsuranceAlertsRequest], ctx context.Context) ([]*dto.InsuranceAlertResponse, error) {
+	window, err := period.ParseWindow(request.Data.Within)
+	if err != nil {
+		return nil, api.ValidationErrors{{Field: "within", Message: err.Error()}}
+	}
+
+	now := time.Now()
+	alerts, err := q.service.GetInsuranceAlerts(ctx, now, now.Add(window))
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.InsuranceAlertResponse, len(alerts))
+	for i, alert := range alerts {
+		responses[i] = dto.CreateInsuranceAlertResponse(alert, now)
+	}
+	return responses, nil
+}
@@ -0,0 +1,32 @@
+package get_model
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetModel"
+
+type GetModelRequest struct {
+	ID uuid.UUID `uri:"id"`
+}
+
+type GetModelQuery struct {
+	service services.ModelService
+}
+
+func NewGetModelQuery(service services.ModelService) *GetModelQuery {
+	return &GetModelQuery{service: service}
+}
+
+func (q *GetModelQuery) Execute(request api.QueryRequest[GetModelRequest], ctx context.Context) (*dto.ModelResponse, error) {
+	model, err := q.service.GetModel(ctx, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateModelResponse(model), nil
+}
@@ -0,0 +1,33 @@
+package get_model_trim
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetModelTrim"
+
+type GetModelTrimRequest struct {
+	ModelID uuid.UUID `uri:"id"`
+	ID      uuid.UUID `uri:"trimId"`
+}
+
+type GetModelTrimQuery struct {
+	service services.ModelService
+}
+
+func NewGetModelTrimQuery(service services.ModelService) *GetModelTrimQuery {
+	return &GetModelTrimQuery{service: service}
+}
+
+func (q *GetModelTrimQuery) Execute(request api.QueryRequest[GetModelTrimRequest], ctx context.Context) (*dto.ModelTrimResponse, error) {
+	trim, err := q.service.GetModelTrim(ctx, request.Data.ModelID, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateModelTrimResponse(trim), nil
+}
@@ -0,0 +1,37 @@
+package get_model_trims
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetModelTrims"
+
+type GetModelTrimsRequest struct {
+	ModelID uuid.UUID `uri:"id"`
+}
+
+type GetModelTrimsQuery struct {
+	service services.ModelService
+}
+
+func NewGetModelTrimsQuery(service services.ModelService) *GetModelTrimsQuery {
+	return &GetModelTrimsQuery{service: service}
+}
+
+func (q *GetModelTrimsQuery) Execute(request api.QueryRequest[GetModelTrimsRequest], ctx context.Context) ([]*dto.ModelTrimResponse, error) {
+	trims, err := q.service.GetModelTrims(ctx, request.Data.ModelID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.ModelTrimResponse, len(trims))
+	for i, trim := range trims {
+		responses[i] = dto.CreateModelTrimResponse(trim)
+	}
+	return responses, nil
+}
@@ -0,0 +1,45 @@
+package get_models
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetModels"
+
+type GetModelsRequest struct {
+	BrandID  uuid.UUID `form:"brandId"`
+	Category string    `form:"category"`
+	Active   bool      `form:"active"`
+	dto.SpecFilterRequest
+}
+
+type GetModelsQuery struct {
+	service services.ModelService
+}
+
+func NewGetModelsQuery(service services.ModelService) *GetModelsQuery {
+	return &GetModelsQuery{service: service}
+}
+
+func (q *GetModelsQuery) Execute(request api.QueryRequest[GetModelsRequest], ctx context.Context) ([]*dto.ModelResponse, error) {
+	specs, validationErrors := dto.ParseSpecFilter(request.Data.SpecFilterRequest)
+	if len(validationErrors) > 0 {
+		return nil, validationErrors
+	}
+
+	models, err := q.service.GetModels(ctx, services.ModelFilter{
+		BrandID:    request.Data.BrandID,
+		Category:   request.Data.Category,
+		ActiveOnly: request.Data.Active,
+		Specs:      specs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateModelResponses(models), nil
+}
@@ -0,0 +1,32 @@
+package get_odometer_history
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetOdometerHistory"
+
+type GetOdometerHistoryRequest struct {
+	CarID uuid.UUID `uri:"id"`
+}
+
+type GetOdometerHistoryQuery struct {
+	service services.OdometerService
+}
+
+func NewGetOdometerHistoryQuery(service services.OdometerService) *GetOdometerHistoryQuery {
+	return &GetOdometerHistoryQuery{service: service}
+}
+
+func (q *GetOdometerHistoryQuery) Execute(request api.QueryRequest[GetOdometerHistoryRequest], ctx context.Context) (*dto.OdometerHistoryResponse, error) {
+	history, err := q.service.GetOdometerHistory(ctx, request.Data.CarID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateOdometerHistoryResponse(history), nil
+}
@@ -0,0 +1,32 @@
+package get_owner
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetOwner"
+
+type GetOwnerRequest struct {
+	ID uuid.UUID `uri:"id"`
+}
+
+type GetOwnerQuery struct {
+	service services.OwnerService
+}
+
+func NewGetOwnerQuery(service services.OwnerService) *GetOwnerQuery {
+	return &GetOwnerQuery{service: service}
+}
+
+func (q *GetOwnerQuery) Execute(request api.QueryRequest[GetOwnerRequest], ctx context.Context) (*dto.OwnerResponse, error) {
+	owner, err := q.service.GetOwner(ctx, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateOwnerResponse(owner), nil
+}
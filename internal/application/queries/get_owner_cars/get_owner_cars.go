@@ -0,0 +1,33 @@
+package get_owner_cars
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetOwnerCars"
+
+type GetOwnerCarsRequest struct {
+	ID uuid.UUID `uri:"id"`
+}
+
+type GetOwnerCarsQuery struct {
+	service services.OwnerService
+}
+
+func NewGetOwnerCarsQuery(service services.OwnerService) *GetOwnerCarsQuery {
+	return &GetOwnerCarsQuery{service: service}
+}
+
+func (q *GetOwnerCarsQuery) Execute(request api.QueryRequest[GetOwnerCarsRequest], ctx context.Context) ([]*dto.CarResponse, error) {
+	cars, err := q.service.GetOwnerCars(ctx, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateCarResponses(cars, repositories.CarRelations{}), nil
+}
@@ -0,0 +1,32 @@
+package get_owner_insurance_policies
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetOwnerInsurancePolicies"
+
+type GetOwnerInsurancePoliciesRequest struct {
+	OwnerID uuid.UUID `uri:"id"`
+}
+
+type GetOwnerInsurancePoliciesQuery struct {
+	service services.InsurancePolicyService
+}
+
+func NewGetOwnerInsurancePoliciesQuery(service services.InsurancePolicyService) *GetOwnerInsurancePoliciesQuery {
+	return &GetOwnerInsurancePoliciesQuery{service: service}
+}
+
+func (q *GetOwnerInsurancePoliciesQuery) Execute(request api.QueryRequest[GetOwnerInsurancePoliciesRequest], ctx context.Context) ([]*dto.InsurancePolicyResponse, error) {
+	policies, err := q.service.GetOwnerPolicies(ctx, request.Data.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateInsurancePolicyResponses(policies), nil
+}
@@ -0,0 +1,28 @@
+package get_owners
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+)
+
+const Name = "GetOwners"
+
+type GetOwnersRequest struct{}
+
+type GetOwnersQuery struct {
+	service services.OwnerService
+}
+
+func NewGetOwnersQuery(service services.OwnerService) *GetOwnersQuery {
+	return &GetOwnersQuery{service: service}
+}
+
+func (q *GetOwnersQuery) Execute(request api.QueryRequest[GetOwnersRequest], ctx context.Context) ([]*dto.OwnerResponse, error) {
+	owners, err := q.service.GetOwners(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateOwnerResponses(owners), nil
+}
@@ -0,0 +1,37 @@
+package get_ownership_history
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetOwnershipHistory"
+
+type GetOwnershipHistoryRequest struct {
+	ID uuid.UUID `uri:"id"`
+}
+
+type GetOwnershipHistoryQuery struct {
+	service services.OwnershipService
+}
+
+func NewGetOwnershipHistoryQuery(service services.OwnershipService) *GetOwnershipHistoryQuery {
+	return &GetOwnershipHistoryQuery{service: service}
+}
+
+func (q *GetOwnershipHistoryQuery) Execute(request api.QueryRequest[GetOwnershipHistoryRequest], ctx context.Context) ([]*dto.OwnershipRecordResponse, error) {
+	records, err := q.service.GetOwnershipHistory(ctx, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.OwnershipRecordResponse, len(records))
+	for i, record := range records {
+		responses[i] = dto.CreateOwnershipRecordResponse(record)
+	}
+	return responses, nil
+}
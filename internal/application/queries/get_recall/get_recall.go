@@ -0,0 +1,32 @@
+package get_recall
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetRecall"
+
+type GetRecallRequest struct {
+	ID uuid.UUID `uri:"id"`
+}
+
+type GetRecallQuery struct {
+	service services.RecallService
+}
+
+func NewGetRecallQuery(service services.RecallService) *GetRecallQuery {
+	return &GetRecallQuery{service: service}
+}
+
+func (q *GetRecallQuery) Execute(request api.QueryRequest[GetRecallRequest], ctx context.Context) (*dto.RecallResponse, error) {
+	detail, err := q.service.GetRecall(ctx, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateRecallResponse(detail), nil
+}
@@ -0,0 +1,45 @@
+package get_recall_affected_cars
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetRecallAffectedCars"
+
+type GetRecallAffectedCarsRequest struct {
+	RecallID uuid.UUID `uri:"id"`
+	Status   string    `form:"status"` // open o remedied; vacío lista todos los vehículos
+}
+
+type GetRecallAffectedCarsQuery struct {
+	service services.RecallService
+}
+
+func NewGetRecallAffectedCarsQuery(service services.RecallService) *GetRecallAffectedCarsQuery {
+	return &GetRecallAffectedCarsQuery{service: service}
+}
+
+func (q *GetRecallAffectedCarsQuery) Execute(request api.QueryRequest[GetRecallAffectedCarsRequest], ctx context.Context) ([]*dto.AffectedCarResponse, error) {
+	status := entities.RecallRemedyStatus(request.Data.Status)
+	if status != "" && !status.IsValid() {
+		return nil, api.ValidationErrors{{Field: "status", Message: fmt.Sprintf("Estado de reparación no soportado: %s", status)}}
+	}
+
+	carRecalls, err := q.service.GetAffectedCars(ctx, request.Data.RecallID, status)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.AffectedCarResponse, len(carRecalls))
+	for i, carRecall := range carRecalls {
+		responses[i] = dto.CreateAffectedCarResponse(carRecall)
+	}
+	return responses, nil
+}
@@ -0,0 +1,33 @@
+package get_service_record
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetServiceRecord"
+
+type GetServiceRecordRequest struct {
+	CarID uuid.UUID `uri:"id"`
+	ID    uuid.UUID `uri:"serviceId"`
+}
+
+type GetServiceRecordQuery struct {
+	service services.ServiceRecordService
+}
+
+func NewGetServiceRecordQuery(service services.ServiceRecordService) *GetServiceRecordQuery {
+	return &GetServiceRecordQuery{service: service}
+}
+
+func (q *GetServiceRecordQuery) Execute(request api.QueryRequest[GetServiceRecordRequest], ctx context.Context) (*dto.ServiceRecordResponse, error) {
+	record, err := q.service.GetServiceRecord(ctx, request.Data.CarID, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateServiceRecordResponse(record), nil
+}
@@ -0,0 +1,37 @@
+package get_service_records
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetServiceRecords"
+
+type GetServiceRecordsRequest struct {
+	CarID uuid.UUID `uri:"id"`
+}
+
+type GetServiceRecordsQuery struct {
+	service services.ServiceRecordService
+}
+
+func NewGetServiceRecordsQuery(service services.ServiceRecordService) *GetServiceRecordsQuery {
+	return &GetServiceRecordsQuery{service: service}
+}
+
+func (q *GetServiceRecordsQuery) Execute(request api.QueryRequest[GetServiceRecordsRequest], ctx context.Context) ([]*dto.ServiceRecordResponse, error) {
+	records, err := q.service.GetServiceRecords(ctx, request.Data.CarID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.ServiceRecordResponse, len(records))
+	for i, record := range records {
+		responses[i] = dto.CreateServiceRecordResponse(record)
+	}
+	return responses, nil
+}
@@ -0,0 +1,32 @@
+package get_stolen_report
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetStolenReport"
+
+type GetStolenReportRequest struct {
+	ID uuid.UUID `uri:"id"`
+}
+
+type GetStolenReportQuery struct {
+	service services.StolenReportService
+}
+
+func NewGetStolenReportQuery(service services.StolenReportService) *GetStolenReportQuery {
+	return &GetStolenReportQuery{service: service}
+}
+
+func (q *GetStolenReportQuery) Execute(request api.QueryRequest[GetStolenReportRequest], ctx context.Context) (*dto.StolenReportResponse, error) {
+	report, err := q.service.GetStolenReport(ctx, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateStolenReportResponse(report), nil
+}
@@ -0,0 +1,32 @@
+package get_warranty_template
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const Name = "GetWarrantyTemplate"
+
+type GetWarrantyTemplateRequest struct {
+	ID uuid.UUID `uri:"id"`
+}
+
+type GetWarrantyTemplateQuery struct {
+	service services.WarrantyService
+}
+
+func NewGetWarrantyTemplateQuery(service services.WarrantyService) *GetWarrantyTemplateQuery {
+	return &GetWarrantyTemplateQuery{service: service}
+}
+
+func (q *GetWarrantyTemplateQuery) Execute(request api.QueryRequest[GetWarrantyTemplateRequest], ctx context.Context) (*dto.WarrantyTemplateResponse, error) {
+	template, err := q.service.GetTemplate(ctx, request.Data.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.CreateWarrantyTemplateResponse(template), nil
+}
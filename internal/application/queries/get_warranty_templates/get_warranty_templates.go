@@ -0,0 +1,33 @@
+package get_warranty_templates
+
+import (
+	api "car-service/cmd/api/mediator"
+	"car-service/internal/application/dto"
+	"car-service/internal/domain/services"
+	"context"
+)
+
+const Name = "GetWarrantyTemplates"
+
+type GetWarrantyTemplatesRequest struct{}
+
+type GetWarrantyTemplatesQuery struct {
+	service services.WarrantyService
+}
+
+func NewGetWarrantyTemplatesQuery(service services.WarrantyService) *GetWarrantyTemplatesQuery {
+	return &GetWarrantyTemplatesQuery{service: service}
+}
+
+func (q *GetWarrantyTemplatesQuery) Execute(request api.QueryRequest[GetWarrantyTemplatesRequest], ctx context.Context) ([]*dto.WarrantyTemplateResponse, error) {
+	templates, err := q.service.GetTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]*dto.WarrantyTemplateResponse, len(templates))
+	for i, template := range templates {
+		responses[i] = dto.CreateWarrantyTemplateResponse(template)
+	}
+	return responses, nil
+}
@@ -0,0 +1,105 @@
+// internal/application/services/brand_service_implementation.go
+
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/errors"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type BrandServiceImpl struct {
+	brandRepo repositories.BrandRepository
+	modelRepo repositories.ModelRepository
+}
+
+func NewBrandService(
+	brandRepo repositories.BrandRepository,
+	modelRepo repositories.ModelRepository,
+) services.BrandService {
+	return &BrandServiceImpl{
+		brandRepo: brandRepo,
+		modelRepo: modelRepo,
+	}
+}
+
+func (s *BrandServiceImpl) CreateBrand(ctx context.Context, brand *entities.Brand) (*entities.Brand, error) {
+	if err := s.validateUniqueName(ctx, brand); err != nil {
+		return nil, err
+	}
+
+	if err := s.brandRepo.Create(ctx, brand); err != nil {
+		return nil, err
+	}
+	return brand, nil
+}
+
+func (s *BrandServiceImpl) GetBrands(ctx context.Context) ([]*entities.Brand, error) {
+	return s.brandRepo.List(ctx)
+}
+
+func (s *BrandServiceImpl) GetBrand(ctx context.Context, id uuid.UUID) (*entities.Brand, error) {
+	return s.brandRepo.GetByID(ctx, id)
+}
+
+func (s *BrandServiceImpl) UpdateBrand(ctx context.Context, brand *entities.Brand) (*entities.Brand, error) {
+	existingBrand, err := s.brandRepo.GetByID(ctx, brand.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateUniqueName(ctx, brand); err != nil {
+		return nil, err
+	}
+
+	brand.CreatedAt = existingBrand.CreatedAt
+	if err := s.brandRepo.Update(ctx, brand); err != nil {
+		return nil, err
+	}
+	return brand, nil
+}
+
+func (s *BrandServiceImpl) DeactivateBrand(ctx context.Context, id uuid.UUID) (*entities.Brand, error) {
+	brand, err := s.brandRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !brand.Active {
+		return nil, errors.NewBusinessError("BRAND_ALREADY_INACTIVE", "La marca ya se encuentra desactivada")
+	}
+
+	brand.Active = false
+	if err := s.brandRepo.Update(ctx, brand); err != nil {
+		return nil, err
+	}
+	return brand, nil
+}
+
+func (s *BrandServiceImpl) DeleteBrand(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.brandRepo.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	models, err := s.modelRepo.GetByBrandID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(models) > 0 {
+		return errors.NewBusinessError("BRAND_HAS_MODELS", "No se puede eliminar una marca con modelos asociados; desactívela en su lugar")
+	}
+
+	return s.brandRepo.Delete(ctx, id)
+}
+
+// validateUniqueName verifica que ninguna otra marca utilice el nombre
+func (s *BrandServiceImpl) validateUniqueName(ctx context.Context, brand *entities.Brand) error {
+	existingBrand, err := s.brandRepo.GetByName(ctx, brand.Name)
+	if err == nil && existingBrand != nil && existingBrand.ID != brand.ID {
+		return errors.NewBusinessError("DUPLICATE_BRAND_NAME", "Ya existe una marca con este nombre")
+	}
+	return nil
+}
@@ -3,56 +3,345 @@
 package services
 
 import (
+	"car-service/internal/domain/decisions"
 	"car-service/internal/domain/entities"
 	"car-service/internal/domain/errors"
+	"car-service/internal/domain/pagination"
 	"car-service/internal/domain/repositories"
 	"car-service/internal/domain/services"
+	"car-service/internal/domain/vin"
 	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
 )
 
 type CarServiceImpl struct {
-	carRepo   repositories.CarRepository
-	modelRepo repositories.ModelRepository
-	ownerRepo repositories.OwnerRepository
+	carRepo       repositories.CarRepository
+	modelRepo     repositories.ModelRepository
+	ownerRepo     repositories.OwnerRepository
+	wmiRepo       repositories.ManufacturerIdentifierRepository
+	ownershipRepo repositories.OwnershipRecordRepository
+	statusRepo    repositories.CarStatusTransitionRepository
+	stolenRepo    repositories.StolenReportRepository
+	recallRepo    repositories.RecallRepository
+	carRecallRepo repositories.CarRecallRepository
+	trimRepo      repositories.ModelTrimRepository
 }
 
 func NewCarService(
 	carRepo repositories.CarRepository,
 	modelRepo repositories.ModelRepository,
 	ownerRepo repositories.OwnerRepository,
+	wmiRepo repositories.ManufacturerIdentifierRepository,
+	ownershipRepo repositories.OwnershipRecordRepository,
+	statusRepo repositories.CarStatusTransitionRepository,
+	stolenRepo repositories.StolenReportRepository,
+	recallRepo repositories.RecallRepository,
+	carRecallRepo repositories.CarRecallRepository,
+	trimRepo repositories.ModelTrimRepository,
 ) services.CarService {
 	return &CarServiceImpl{
-		carRepo:   carRepo,
-		modelRepo: modelRepo,
-		ownerRepo: ownerRepo,
+		carRepo:       carRepo,
+		modelRepo:     modelRepo,
+		ownerRepo:     ownerRepo,
+		wmiRepo:       wmiRepo,
+		ownershipRepo: ownershipRepo,
+		statusRepo:    statusRepo,
+		stolenRepo:    stolenRepo,
+		recallRepo:    recallRepo,
+		carRecallRepo: carRecallRepo,
+		trimRepo:      trimRepo,
 	}
 }
 
 func (s *CarServiceImpl) CreateCar(ctx context.Context, car *entities.Car) (*entities.Car, error) {
-	existingCar, err := s.carRepo.GetByVIN(car.VIN)
+	existingCar, err := s.carRepo.GetByVIN(ctx, car.VIN)
 	if err == nil && existingCar != nil {
 		return nil, errors.NewBusinessError("DUPLICATE_VIN", "Ya existe un vehículo con este número de VIN")
 	}
 
-	modelExists, err := s.modelRepo.ExistsByID(car.ModelID)
+	if err := checkWatchlist(ctx, s.stolenRepo, car.VIN); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateReferences(ctx, car); err != nil {
+		return nil, err
+	}
+
+	model, err := s.modelRepo.GetByID(ctx, car.ModelID)
 	if err != nil {
 		return nil, err
 	}
-	if !modelExists {
-		return nil, errors.NewBusinessError("MODEL_NOT_FOUND", "El modelo especificado no existe")
+
+	if err := validateModelActive(model); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProductionRange(ctx, car, model); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateManufacturer(ctx, car, model); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateTrim(ctx, car); err != nil {
+		return nil, err
+	}
+
+	car.Status = entities.CarStatusRegistered
+	created, err := s.carRepo.Create(ctx, car)
+	if err != nil {
+		return nil, err
+	}
+
+	// El alta abre el primer registro del historial de propietarios
+	record := entities.NewOwnershipRecord(created.ID, created.OwnerID, created.CreatedAt, nil, "")
+	if err := s.ownershipRepo.Create(ctx, record); err != nil {
+		return nil, err
+	}
+
+	// y el historial de estados con el estado inicial
+	transition := entities.NewCarStatusTransition(created.ID, "", created.Status, "Alta del vehículo")
+	if err := s.statusRepo.Create(ctx, transition); err != nil {
+		return nil, err
+	}
+
+	if err := matchCarRecalls(ctx, s.recallRepo, s.carRecallRepo, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (s *CarServiceImpl) GetCars(ctx context.Context, filter repositories.CarFilter) (*pagination.Page[*entities.Car], error) {
+	cars, total, err := s.carRepo.Search(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	return pagination.NewPage(cars, filter.Page, total), nil
+}
+
+func (s *CarServiceImpl) GetCar(ctx context.Context, id uuid.UUID) (*entities.Car, error) {
+	return s.carRepo.GetByID(ctx, id)
+}
+
+func (s *CarServiceImpl) GetCarDetail(ctx context.Context, id uuid.UUID, relations repositories.CarRelations) (*entities.Car, error) {
+	return s.carRepo.GetByIDWithRelations(ctx, id, relations)
+}
+
+func (s *CarServiceImpl) UpdateCar(ctx context.Context, car *entities.Car) (*entities.Car, error) {
+	existingCar, err := s.carRepo.GetByID(ctx, car.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if car.VIN != existingCar.VIN {
+		return nil, errors.NewBusinessError("VIN_IMMUTABLE", "El VIN de un vehículo no puede modificarse")
+	}
+
+	if car.OwnerID != existingCar.OwnerID {
+		return nil, errors.NewBusinessError("OWNER_CHANGE_REQUIRES_TRANSFER",
+			"El propietario de un vehículo solo puede cambiarse mediante una transferencia de titularidad")
+	}
+
+	if err := s.validateReferences(ctx, car); err != nil {
+		return nil, err
+	}
+
+	if car.ModelID != existingCar.ModelID || car.Year != existingCar.Year {
+		model, err := s.modelRepo.GetByID(ctx, car.ModelID)
+		if err != nil {
+			return nil, err
+		}
+
+		// Un modelo desactivado no impide corregir el año de los autos que ya lo usan
+		if car.ModelID != existingCar.ModelID {
+			if err := validateModelActive(model); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := s.validateProductionRange(ctx, car, model); err != nil {
+			return nil, err
+		}
+
+		if car.ModelID != existingCar.ModelID {
+			if err := s.validateManufacturer(ctx, car, model); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := s.validateTrim(ctx, car); err != nil {
+		return nil, err
+	}
+
+	// El estado solo se modifica mediante ChangeCarStatus
+	car.Status = existingCar.Status
+	car.CreatedAt = existingCar.CreatedAt
+	if err := s.carRepo.Update(ctx, car); err != nil {
+		return nil, err
+	}
+
+	// Un cambio de modelo o de año puede dejar al auto alcanzado por otras campañas
+	if car.ModelID != existingCar.ModelID || car.Year != existingCar.Year {
+		if err := matchCarRecalls(ctx, s.recallRepo, s.carRecallRepo, car); err != nil {
+			return nil, err
+		}
+	}
+	return car, nil
+}
+
+func (s *CarServiceImpl) DeleteCar(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.carRepo.GetByID(ctx, id); err != nil {
+		return err
+	}
+	return s.carRepo.Delete(ctx, id)
+}
+
+// ChangeCarStatus aplica una transición del ciclo de vida y la registra en el historial.
+// El estado stolen acompaña a la denuncia de robo: solo se asigna con ReportStolen y, mientras
+// la denuncia esté vigente, solo se abandona con RecoverStolen.
+func (s *CarServiceImpl) ChangeCarStatus(ctx context.Context, id uuid.UUID, status entities.CarStatus, reason string) (*entities.Car, error) {
+	car, err := s.carRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateStolenTransition(ctx, car, status); err != nil {
+		return nil, err
 	}
 
-	ownerExists, err := s.ownerRepo.ExistsByID(car.OwnerID)
+	transition, err := car.ChangeStatus(status, reason)
 	if err != nil {
 		return nil, err
 	}
+
+	if err := s.carRepo.Update(ctx, car); err != nil {
+		return nil, err
+	}
+
+	if err := s.statusRepo.Create(ctx, transition); err != nil {
+		return nil, err
+	}
+
+	decisions.Record(ctx, fmt.Sprintf("El vehículo pasó del estado %s al estado %s", transition.FromStatus, transition.ToStatus))
+	return car, nil
+}
+
+func (s *CarServiceImpl) GetStatusHistory(ctx context.Context, id uuid.UUID) ([]*entities.CarStatusTransition, error) {
+	if _, err := s.carRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.statusRepo.ListByCarID(ctx, id)
+}
+
+// validateStolenTransition impide que un cambio de estado deje el estado stolen desincronizado
+// de la lista de vigilancia. Un vehículo en estado stolen sin denuncia vigente puede salir de él.
+func (s *CarServiceImpl) validateStolenTransition(ctx context.Context, car *entities.Car, status entities.CarStatus) error {
+	if status == entities.CarStatusStolen && car.Status != entities.CarStatusStolen {
+		return errors.NewBusinessError("STOLEN_STATUS_REQUIRES_REPORT",
+			"El estado stolen solo puede asignarse registrando una denuncia de robo")
+	}
+
+	if car.Status != entities.CarStatusStolen || status == entities.CarStatusStolen {
+		return nil
+	}
+
+	report, err := s.stolenRepo.GetActiveByVIN(ctx, car.VIN)
+	if err != nil {
+		return err
+	}
+	if report != nil {
+		return errors.NewBusinessError("STOLEN_REPORT_ACTIVE",
+			fmt.Sprintf("El vehículo tiene una denuncia de robo vigente (%s); registre el recupero para cambiar su estado", report.PoliceReference))
+	}
+	decisions.Record(ctx, "El vehículo estaba en estado stolen sin una denuncia de robo vigente; se permite el cambio de estado")
+	return nil
+}
+
+// validateReferences verifica que el modelo y el propietario del auto existan
+func (s *CarServiceImpl) validateReferences(ctx context.Context, car *entities.Car) error {
+	modelExists, err := s.modelRepo.ExistsByID(ctx, car.ModelID)
+	if err != nil {
+		return err
+	}
+	if !modelExists {
+		return errors.NewBusinessError("MODEL_NOT_FOUND", "El modelo especificado no existe")
+	}
+
+	ownerExists, err := s.ownerRepo.ExistsByID(ctx, car.OwnerID)
+	if err != nil {
+		return err
+	}
 	if !ownerExists {
-		return nil, errors.NewBusinessError("OWNER_NOT_FOUND", "El propietario especificado no existe")
+		return errors.NewBusinessError("OWNER_NOT_FOUND", "El propietario especificado no existe")
+	}
+	return nil
+}
+
+// validateTrim verifica que la versión informada exista y pertenezca al modelo del auto
+func (s *CarServiceImpl) validateTrim(ctx context.Context, car *entities.Car) error {
+	if car.TrimID == nil {
+		return nil
 	}
 
-	return s.carRepo.Create(ctx, car)
+	if _, err := s.trimRepo.GetByID(ctx, car.ModelID, *car.TrimID); err != nil {
+		return errors.NewBusinessError("TRIM_NOT_FOUND", "La versión especificada no existe para el modelo del vehículo")
+	}
+	return nil
 }
 
-func (s *CarServiceImpl) GetCars(ctx context.Context) ([]*entities.Car, error) {
-	return s.carRepo.List()
+// validateModelActive impide asignar un modelo desactivado
+func validateModelActive(model *entities.Model) error {
+	if !model.Active {
+		return errors.NewBusinessError("MODEL_INACTIVE", "El modelo especificado no está activo")
+	}
+	return nil
+}
+
+// validateProductionRange verifica que el año del auto esté dentro del rango de producción del modelo
+func (s *CarServiceImpl) validateProductionRange(ctx context.Context, car *entities.Car, model *entities.Model) error {
+	if car.Year < model.StartYear {
+		return errors.NewBusinessError("YEAR_BEFORE_PRODUCTION",
+			fmt.Sprintf("El año %d es anterior al inicio de producción del modelo (%d)", car.Year, model.StartYear))
+	}
+
+	if model.EndYear != 0 && car.Year > model.EndYear {
+		return errors.NewBusinessError("YEAR_AFTER_PRODUCTION",
+			fmt.Sprintf("El año %d es posterior al fin de producción del modelo (%d)", car.Year, model.EndYear))
+	}
+
+	endYear := "actualidad"
+	if model.EndYear != 0 {
+		endYear = strconv.Itoa(model.EndYear)
+	}
+	decisions.Record(ctx, fmt.Sprintf("El año %d está dentro del rango de producción del modelo %s (%d-%s)",
+		car.Year, model.Name, model.StartYear, endYear))
+	return nil
+}
+
+// validateManufacturer compara la marca asociada al WMI del VIN con la marca del modelo.
+// Un WMI desconocido no bloquea el alta, pero queda registrado como decisión.
+func (s *CarServiceImpl) validateManufacturer(ctx context.Context, car *entities.Car, model *entities.Model) error {
+	code := vin.WMI(car.VIN)
+	identifier, err := s.wmiRepo.GetByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+	if identifier == nil {
+		decisions.Record(ctx, fmt.Sprintf("El WMI %s del VIN no está registrado; no se verificó la marca del fabricante", code))
+		return nil
+	}
+
+	if identifier.BrandID != model.BrandID {
+		decisions.Record(ctx, fmt.Sprintf("El WMI %s del VIN corresponde a la marca %s y no a la marca del modelo %s",
+			code, identifier.Brand.Name, model.Name))
+		return errors.NewBusinessError("VIN_BRAND_MISMATCH", "El fabricante indicado por el VIN no coincide con la marca del modelo")
+	}
+
+	decisions.Record(ctx, fmt.Sprintf("El WMI %s del VIN coincide con la marca %s del modelo", code, identifier.Brand.Name))
+	return nil
 }
@@ -0,0 +1,256 @@
+package services_test
+
+import (
+	"car-service/internal/application/services"
+	"car-service/internal/domain/entities"
+	domainerrors "car-service/internal/domain/errors"
+	domainservices "car-service/internal/domain/services"
+	"car-service/internal/domain/vin"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// withCheckDigit reemplaza la posición 9 del VIN por el dígito verificador correcto
+func withCheckDigit(value string) string {
+	return value[:8] + string(vin.CheckDigit(value)) + value[9:]
+}
+
+// businessCode retorna el código del error de negocio, o vacío si el error no lo es
+func businessCode(err error) string {
+	var businessErr *domainerrors.BusinessError
+	if errors.As(err, &businessErr) {
+		return businessErr.Code
+	}
+	return ""
+}
+
+// date retorna la fecha indicada a las 00:00 UTC
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func containsDecision(decisions []string, fragment string) bool {
+	for _, decision := range decisions {
+		if strings.Contains(decision, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// carFixture arma el servicio de autos con un modelo Toyota, un propietario y los WMI JTD (Toyota) y 1HG (Honda)
+type carFixture struct {
+	service    domainservices.CarService
+	cars       *fakeCarRepo
+	wmi        *fakeWMIRepo
+	stolen     *fakeStolenRepo
+	recalls    *fakeRecallRepo
+	carRecalls *fakeCarRecallRepo
+	model      *entities.Model
+	owner      *entities.Owner
+}
+
+func newCarFixture() *carFixture {
+	toyota := &entities.Brand{ID: uuid.New(), Name: "Toyota"}
+	honda := &entities.Brand{ID: uuid.New(), Name: "Honda"}
+	model := entities.NewModel("Corolla", toyota.ID, 2015, "Sedan")
+	owner := entities.NewOwner("Ana", "ana@example.com", "", "")
+
+	toyotaWMI := entities.NewManufacturerIdentifier("JTD", toyota.ID)
+	toyotaWMI.Brand = *toyota
+	hondaWMI := entities.NewManufacturerIdentifier("1HG", honda.ID)
+	hondaWMI.Brand = *honda
+
+	f := &carFixture{
+		cars:       newFakeCarRepo(),
+		wmi:        newFakeWMIRepo(toyotaWMI, hondaWMI),
+		stolen:     &fakeStolenRepo{},
+		recalls:    &fakeRecallRepo{},
+		carRecalls: &fakeCarRecallRepo{},
+		model:      model,
+		owner:      owner,
+	}
+	f.service = services.NewCarService(f.cars, newFakeModelRepo(model), newFakeOwnerRepo(owner), f.wmi,
+		&fakeOwnershipRepo{}, &fakeStatusRepo{}, f.stolen, f.recalls, f.carRecalls, &fakeTrimRepo{})
+	return f
+}
+
+func (f *carFixture) newCar(vinValue string) *entities.Car {
+	return entities.NewCar(f.model.ID, 2020, "Rojo", vinValue, f.owner.ID)
+}
+
+func TestCreateCarValidatesManufacturer(t *testing.T) {
+	errDatabase := errors.New("conexión perdida")
+
+	tests := []struct {
+		name     string
+		vin      string
+		wmiErr   error
+		err      error
+		code     string
+		decision string
+	}{
+		{name: "WMI de la marca del modelo", vin: withCheckDigit("JTDBR32E0L0123456"), decision: "coincide con la marca Toyota"},
+		{name: "WMI de otra marca", vin: withCheckDigit("1HGCM8263LA004352"), code: "VIN_BRAND_MISMATCH", decision: "corresponde a la marca Honda"},
+		{name: "WMI no registrado", vin: withCheckDigit("WVWZZZ1J0L0123456"), decision: "no está registrado"},
+		{name: "error del repositorio de WMI", vin: withCheckDigit("JTDBR32E0L0123456"), wmiErr: errDatabase, err: errDatabase},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCarFixture()
+			f.wmi.err = tt.wmiErr
+			ctx, log := newDecisionContext()
+
+			created, err := f.service.CreateCar(ctx, f.newCar(tt.vin))
+
+			switch {
+			case tt.err != nil:
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("error = %v, se esperaba %v", err, tt.err)
+				}
+			case tt.code != "":
+				if code := businessCode(err); code != tt.code {
+					t.Fatalf("código = %q (%v), se esperaba %q", code, err, tt.code)
+				}
+			case err != nil:
+				t.Fatalf("error inesperado: %v", err)
+			}
+
+			if (created != nil) != (tt.err == nil && tt.code == "") {
+				t.Errorf("auto creado = %v", created != nil)
+			}
+			if tt.err != nil || tt.code != "" {
+				if len(f.cars.cars) != 0 {
+					t.Errorf("no se esperaba persistir el auto, hay %d", len(f.cars.cars))
+				}
+			}
+			if tt.decision != "" && !containsDecision(log.All(), tt.decision) {
+				t.Errorf("decisiones = %v, se esperaba una con %q", log.All(), tt.decision)
+			}
+			if tt.err != nil && containsDecision(log.All(), "WMI") {
+				t.Errorf("un error del repositorio no debería registrar una decisión sobre el WMI: %v", log.All())
+			}
+		})
+	}
+}
+
+func TestChangeCarStatusRequiresStolenReports(t *testing.T) {
+	tests := []struct {
+		name         string
+		from         entities.CarStatus
+		to           entities.CarStatus
+		activeReport bool
+		code         string
+	}{
+		{name: "a stolen sin denuncia", from: entities.CarStatusRegistered, to: entities.CarStatusStolen, code: "STOLEN_STATUS_REQUIRES_REPORT"},
+		{name: "desde stolen con denuncia vigente", from: entities.CarStatusStolen, to: entities.CarStatusRegistered, activeReport: true, code: "STOLEN_REPORT_ACTIVE"},
+		{name: "a desguace con denuncia vigente", from: entities.CarStatusStolen, to: entities.CarStatusScrapped, activeReport: true, code: "STOLEN_REPORT_ACTIVE"},
+		{name: "desde stolen sin denuncia vigente", from: entities.CarStatusStolen, to: entities.CarStatusRegistered},
+		{name: "transición sin robo", from: entities.CarStatusRegistered, to: entities.CarStatusInService},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCarFixture()
+			car := f.newCar(withCheckDigit("JTDBR32E0L0123456"))
+			car.Status = tt.from
+			f.cars.cars[car.ID] = car
+			if tt.activeReport {
+				f.stolen.reports = append(f.stolen.reports, entities.NewStolenReport(car.VIN, &car.ID, date(2024, 3, 1), "DEN-1", ""))
+			}
+
+			_, err := f.service.ChangeCarStatus(context.Background(), car.ID, tt.to, "Prueba")
+
+			if code := businessCode(err); code != tt.code || (tt.code == "" && err != nil) {
+				t.Fatalf("código = %q (%v), se esperaba %q", code, err, tt.code)
+			}
+			expected := tt.to
+			if tt.code != "" {
+				expected = tt.from
+			}
+			if car.Status != expected {
+				t.Errorf("estado = %s, se esperaba %s", car.Status, expected)
+			}
+		})
+	}
+}
+
+func TestCreateCarChecksWatchlist(t *testing.T) {
+	recovered := date(2023, time.January, 10)
+	tests := []struct {
+		name      string
+		reported  bool
+		recovered *time.Time
+		code      string
+	}{
+		{name: "sin denuncias"},
+		{name: "denuncia vigente", reported: true, code: "VIN_REPORTED_STOLEN"},
+		{name: "denuncia con recupero", reported: true, recovered: &recovered},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCarFixture()
+			car := f.newCar(withCheckDigit("JTDBR32E0L0123456"))
+			if tt.reported {
+				report := entities.NewStolenReport(car.VIN, nil, date(2022, time.May, 3), "DEN-1", "")
+				report.RecoveredDate = tt.recovered
+				f.stolen.reports = append(f.stolen.reports, report)
+			}
+
+			_, err := f.service.CreateCar(context.Background(), car)
+
+			if code := businessCode(err); code != tt.code || (tt.code == "" && err != nil) {
+				t.Fatalf("código = %q (%v), se esperaba %q", code, err, tt.code)
+			}
+			if persisted := len(f.cars.cars) == 1; persisted != (tt.code == "") {
+				t.Errorf("auto persistido = %v", persisted)
+			}
+		})
+	}
+}
+
+func TestUpdateCarRematchesRecalls(t *testing.T) {
+	f := newCarFixture()
+	car := f.newCar(withCheckDigit("JTDBR32E0L0123456"))
+	f.cars.cars[car.ID] = car
+
+	issued := date(2023, time.March, 1)
+	previousYears := entities.NewRecall(f.model.ID, "R-2018", "", "Airbag", "", 2018, 2020, "", "", issued)
+	remedied := entities.NewRecall(f.model.ID, "R-2019", "", "Frenos", "", 2019, 2020, "", "", issued)
+	newYears := entities.NewRecall(f.model.ID, "R-2021", "", "Motor", "", 2021, 2023, "", "", issued)
+	outOfRange := entities.NewRecall(f.model.ID, "R-VIN", "", "Dirección", "", 2021, 2023, "JTDBR32E0M0500000", "JTDBR32E0M0599999", issued)
+	f.recalls.recalls = []*entities.Recall{previousYears, remedied, newYears, outOfRange}
+
+	open := entities.NewCarRecall(previousYears.ID, car.ID)
+	open.Recall = *previousYears
+	closed := entities.NewCarRecall(remedied.ID, car.ID)
+	closed.Recall = *remedied
+	closed.Remedy(date(2023, time.June, 1), "Taller Norte", "")
+	f.carRecalls.carRecalls = []*entities.CarRecall{open, closed}
+
+	updated := *car
+	updated.Year = 2022
+	ctx, log := newDecisionContext()
+	if _, err := f.service.UpdateCar(ctx, &updated); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+
+	var campaigns []string
+	for _, carRecall := range f.carRecalls.carRecalls {
+		for _, recall := range f.recalls.recalls {
+			if recall.ID == carRecall.RecallID {
+				campaigns = append(campaigns, recall.Campaign)
+			}
+		}
+	}
+	if expected := []string{"R-2019", "R-2021"}; strings.Join(campaigns, ",") != strings.Join(expected, ",") {
+		t.Errorf("campañas vinculadas = %v, se esperaba %v", campaigns, expected)
+	}
+	if !containsDecision(log.All(), "dejó de estar alcanzado por la campaña pendiente R-2018") {
+		t.Errorf("decisiones = %v, se esperaba la desvinculación de R-2018", log.All())
+	}
+}
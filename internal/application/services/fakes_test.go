@@ -0,0 +1,459 @@
+package services_test
+
+import (
+	"car-service/internal/domain/decisions"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	domainservices "car-service/internal/domain/services"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Los repositorios falsos guardan los datos en memoria y embeben la interfaz que implementan:
+// un método no implementado entra en pánico y señala una dependencia inesperada del servicio.
+
+// decisionLog acumula las decisiones registradas por el servicio
+type decisionLog struct {
+	mu        sync.Mutex
+	decisions []string
+}
+
+func (l *decisionLog) AddDecision(decision string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.decisions = append(l.decisions, decision)
+}
+
+func (l *decisionLog) All() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.decisions...)
+}
+
+// newDecisionContext retorna un contexto que registra las decisiones en el log
+func newDecisionContext() (context.Context, *decisionLog) {
+	log := &decisionLog{}
+	return decisions.WithRecorder(context.Background(), log), log
+}
+
+type fakeCarRepo struct {
+	repositories.CarRepository
+	cars map[uuid.UUID]*entities.Car
+}
+
+func newFakeCarRepo(cars ...*entities.Car) *fakeCarRepo {
+	repo := &fakeCarRepo{cars: make(map[uuid.UUID]*entities.Car)}
+	for _, car := range cars {
+		repo.cars[car.ID] = car
+	}
+	return repo
+}
+
+func (r *fakeCarRepo) Create(ctx context.Context, car *entities.Car) (*entities.Car, error) {
+	if car.ID == uuid.Nil {
+		car.ID = uuid.New()
+	}
+	r.cars[car.ID] = car
+	return car, nil
+}
+
+func (r *fakeCarRepo) GetByID(ctx context.Context, id uuid.UUID) (*entities.Car, error) {
+	car, ok := r.cars[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return car, nil
+}
+
+func (r *fakeCarRepo) GetByVIN(ctx context.Context, vin string) (*entities.Car, error) {
+	for _, car := range r.cars {
+		if car.VIN == vin {
+			return car, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+// GetByIDWithRelations retorna el auto con las relaciones que ya tenga asignadas
+func (r *fakeCarRepo) GetByIDWithRelations(ctx context.Context, id uuid.UUID, relations repositories.CarRelations) (*entities.Car, error) {
+	return r.GetByID(ctx, id)
+}
+
+func (r *fakeCarRepo) Update(ctx context.Context, car *entities.Car) error {
+	r.cars[car.ID] = car
+	return nil
+}
+
+type fakeModelRepo struct {
+	repositories.ModelRepository
+	models map[uuid.UUID]*entities.Model
+}
+
+func newFakeModelRepo(models ...*entities.Model) *fakeModelRepo {
+	repo := &fakeModelRepo{models: make(map[uuid.UUID]*entities.Model)}
+	for _, model := range models {
+		repo.models[model.ID] = model
+	}
+	return repo
+}
+
+func (r *fakeModelRepo) GetByID(ctx context.Context, id uuid.UUID) (*entities.Model, error) {
+	model, ok := r.models[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return model, nil
+}
+
+func (r *fakeModelRepo) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
+	_, ok := r.models[id]
+	return ok, nil
+}
+
+type fakeOwnerRepo struct {
+	repositories.OwnerRepository
+	owners map[uuid.UUID]*entities.Owner
+}
+
+func newFakeOwnerRepo(owners ...*entities.Owner) *fakeOwnerRepo {
+	repo := &fakeOwnerRepo{owners: make(map[uuid.UUID]*entities.Owner)}
+	for _, owner := range owners {
+		repo.owners[owner.ID] = owner
+	}
+	return repo
+}
+
+func (r *fakeOwnerRepo) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
+	_, ok := r.owners[id]
+	return ok, nil
+}
+
+// fakeWMIRepo retorna err en todas las consultas si está definido
+type fakeWMIRepo struct {
+	repositories.ManufacturerIdentifierRepository
+	identifiers map[string]*entities.ManufacturerIdentifier
+	err         error
+}
+
+func newFakeWMIRepo(identifiers ...*entities.ManufacturerIdentifier) *fakeWMIRepo {
+	repo := &fakeWMIRepo{identifiers: make(map[string]*entities.ManufacturerIdentifier)}
+	for _, identifier := range identifiers {
+		repo.identifiers[identifier.Code] = identifier
+	}
+	return repo
+}
+
+func (r *fakeWMIRepo) GetByCode(ctx context.Context, code string) (*entities.ManufacturerIdentifier, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.identifiers[code], nil
+}
+
+type fakeOwnershipRepo struct {
+	repositories.OwnershipRecordRepository
+	records []*entities.OwnershipRecord
+}
+
+func (r *fakeOwnershipRepo) Create(ctx context.Context, record *entities.OwnershipRecord) error {
+	r.records = append(r.records, record)
+	return nil
+}
+
+func (r *fakeOwnershipRepo) Update(ctx context.Context, record *entities.OwnershipRecord) error {
+	return nil
+}
+
+func (r *fakeOwnershipRepo) GetCurrentByCarID(ctx context.Context, carID uuid.UUID) (*entities.OwnershipRecord, error) {
+	for _, record := range r.records {
+		if record.CarID == carID && record.IsCurrent() {
+			return record, nil
+		}
+	}
+	return nil, nil
+}
+
+type fakeStatusRepo struct {
+	repositories.CarStatusTransitionRepository
+	transitions []*entities.CarStatusTransition
+}
+
+func (r *fakeStatusRepo) Create(ctx context.Context, transition *entities.CarStatusTransition) error {
+	r.transitions = append(r.transitions, transition)
+	return nil
+}
+
+type fakeStolenRepo struct {
+	repositories.StolenReportRepository
+	reports []*entities.StolenReport
+}
+
+func (r *fakeStolenRepo) Create(ctx context.Context, report *entities.StolenReport) error {
+	r.reports = append(r.reports, report)
+	return nil
+}
+
+func (r *fakeStolenRepo) Update(ctx context.Context, report *entities.StolenReport) error {
+	return nil
+}
+
+func (r *fakeStolenRepo) GetByID(ctx context.Context, id uuid.UUID) (*entities.StolenReport, error) {
+	for _, report := range r.reports {
+		if report.ID == id {
+			return report, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeStolenRepo) GetActiveByVIN(ctx context.Context, vin string) (*entities.StolenReport, error) {
+	for _, report := range r.reports {
+		if report.VIN == vin && report.IsActive() {
+			return report, nil
+		}
+	}
+	return nil, nil
+}
+
+type fakeRecallRepo struct {
+	repositories.RecallRepository
+	recalls []*entities.Recall
+}
+
+func (r *fakeRecallRepo) ListByModelID(ctx context.Context, modelID uuid.UUID) ([]*entities.Recall, error) {
+	var recalls []*entities.Recall
+	for _, recall := range r.recalls {
+		if recall.ModelID == modelID {
+			recalls = append(recalls, recall)
+		}
+	}
+	return recalls, nil
+}
+
+type fakeCarRecallRepo struct {
+	repositories.CarRecallRepository
+	carRecalls []*entities.CarRecall
+}
+
+func (r *fakeCarRecallRepo) CreateBatch(ctx context.Context, carRecalls []*entities.CarRecall) error {
+	r.carRecalls = append(r.carRecalls, carRecalls...)
+	return nil
+}
+
+func (r *fakeCarRecallRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	carRecalls := r.carRecalls[:0]
+	for _, carRecall := range r.carRecalls {
+		if carRecall.ID != id {
+			carRecalls = append(carRecalls, carRecall)
+		}
+	}
+	r.carRecalls = carRecalls
+	return nil
+}
+
+func (r *fakeCarRecallRepo) ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.CarRecall, error) {
+	var carRecalls []*entities.CarRecall
+	for _, carRecall := range r.carRecalls {
+		if carRecall.CarID == carID {
+			carRecalls = append(carRecalls, carRecall)
+		}
+	}
+	return carRecalls, nil
+}
+
+type fakeTrimRepo struct {
+	repositories.ModelTrimRepository
+}
+
+type fakePolicyRepo struct {
+	repositories.InsurancePolicyRepository
+	policies []*entities.InsurancePolicy
+	updated  []*entities.InsurancePolicy
+}
+
+func (r *fakePolicyRepo) Update(ctx context.Context, policy *entities.InsurancePolicy) error {
+	r.updated = append(r.updated, policy)
+	return nil
+}
+
+func (r *fakePolicyRepo) ListUnexpiredByCarID(ctx context.Context, carID uuid.UUID, at time.Time) ([]*entities.InsurancePolicy, error) {
+	var policies []*entities.InsurancePolicy
+	for _, policy := range r.policies {
+		if policy.CarID == carID && policy.EffectiveEnd().After(at) {
+			policies = append(policies, policy)
+		}
+	}
+	return policies, nil
+}
+
+type fakeServiceRecordRepo struct {
+	repositories.ServiceRecordRepository
+	records []*entities.ServiceRecord
+}
+
+func (r *fakeServiceRecordRepo) Create(ctx context.Context, record *entities.ServiceRecord) error {
+	r.records = append(r.records, record)
+	return nil
+}
+
+func (r *fakeServiceRecordRepo) GetByID(ctx context.Context, carID, id uuid.UUID) (*entities.ServiceRecord, error) {
+	for _, record := range r.records {
+		if record.CarID == carID && record.ID == id {
+			return record, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeServiceRecordRepo) Update(ctx context.Context, record *entities.ServiceRecord) error {
+	for i, existing := range r.records {
+		if existing.ID == record.ID {
+			r.records[i] = record
+		}
+	}
+	return nil
+}
+
+func (r *fakeServiceRecordRepo) ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.ServiceRecord, error) {
+	var records []*entities.ServiceRecord
+	for _, record := range r.records {
+		if record.CarID == carID {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// fakeOdometerService registra las lecturas sincronizadas desde servicios e inspecciones
+type fakeOdometerService struct {
+	domainservices.OdometerService
+	readings []*entities.OdometerReading
+}
+
+func (s *fakeOdometerService) RecordSourceReading(ctx context.Context, reading *entities.OdometerReading) error {
+	s.readings = append(s.readings, reading)
+	return nil
+}
+
+func (s *fakeOdometerService) GetOdometerHistory(ctx context.Context, carID uuid.UUID) (*domainservices.OdometerHistory, error) {
+	history := &domainservices.OdometerHistory{}
+	for _, reading := range s.readings {
+		if reading.CarID == carID {
+			history.Readings = append(history.Readings, reading)
+		}
+	}
+	return history, nil
+}
+
+// fakeWarrantyService no cubre ningún servicio
+type fakeWarrantyService struct {
+	domainservices.WarrantyService
+}
+
+func (s *fakeWarrantyService) CoveringService(ctx context.Context, record *entities.ServiceRecord) (*entities.WarrantyCoverage, error) {
+	return nil, nil
+}
+
+type fakeOdometerRepo struct {
+	repositories.OdometerReadingRepository
+	readings []*entities.OdometerReading
+}
+
+func (r *fakeOdometerRepo) Create(ctx context.Context, reading *entities.OdometerReading) error {
+	r.readings = append(r.readings, reading)
+	return nil
+}
+
+func (r *fakeOdometerRepo) Update(ctx context.Context, reading *entities.OdometerReading) error {
+	return nil
+}
+
+func (r *fakeOdometerRepo) DeleteBySource(ctx context.Context, source entities.OdometerSource, sourceID uuid.UUID) error {
+	readings := r.readings[:0]
+	for _, reading := range r.readings {
+		if reading.Source != source || reading.SourceID == nil || *reading.SourceID != sourceID {
+			readings = append(readings, reading)
+		}
+	}
+	r.readings = readings
+	return nil
+}
+
+func (r *fakeOdometerRepo) ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.OdometerReading, error) {
+	var readings []*entities.OdometerReading
+	for _, reading := range r.readings {
+		if reading.CarID == carID {
+			readings = append(readings, reading)
+		}
+	}
+	return readings, nil
+}
+
+type fakeRegistrationRepo struct {
+	repositories.RegistrationRepository
+	registrations []*entities.Registration
+}
+
+func (r *fakeRegistrationRepo) Create(ctx context.Context, registration *entities.Registration) error {
+	r.registrations = append(r.registrations, registration)
+	return nil
+}
+
+func (r *fakeRegistrationRepo) Update(ctx context.Context, registration *entities.Registration) error {
+	return nil
+}
+
+func (r *fakeRegistrationRepo) GetActiveByPlate(ctx context.Context, country, plate string) (*entities.Registration, error) {
+	for _, registration := range r.registrations {
+		if registration.Country == country && registration.Plate == plate && registration.IsActive() {
+			return registration, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeRegistrationRepo) GetActiveByCarID(ctx context.Context, carID uuid.UUID) (*entities.Registration, error) {
+	for _, registration := range r.registrations {
+		if registration.CarID == carID && registration.IsActive() {
+			return registration, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeRegistrationRepo) ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.Registration, error) {
+	var registrations []*entities.Registration
+	for _, registration := range r.registrations {
+		if registration.CarID == carID {
+			registrations = append(registrations, registration)
+		}
+	}
+	return registrations, nil
+}
+
+type fakeWarrantyTemplateRepo struct {
+	repositories.WarrantyTemplateRepository
+	templates []*entities.WarrantyTemplate
+}
+
+func (r *fakeWarrantyTemplateRepo) GetByModelID(ctx context.Context, modelID uuid.UUID) (*entities.WarrantyTemplate, error) {
+	for _, template := range r.templates {
+		if template.ModelID != nil && *template.ModelID == modelID {
+			return template, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeWarrantyTemplateRepo) GetByBrandID(ctx context.Context, brandID uuid.UUID) (*entities.WarrantyTemplate, error) {
+	for _, template := range r.templates {
+		if template.ModelID == nil && template.BrandID == brandID {
+			return template, nil
+		}
+	}
+	return nil, nil
+}
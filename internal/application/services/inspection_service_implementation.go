@@ -0,0 +1,135 @@
+// internal/application/services/inspection_service_implementation.go
+
+package services
+
+import (
+	"car-service/internal/domain/decisions"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/errors"
+	"car-service/internal/domain/inspection"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// uninspectableStatuses son los estados en los que un vehículo no se presenta a inspección
+var uninspectableStatuses = []entities.CarStatus{
+	entities.CarStatusStolen,
+	entities.CarStatusScrapped,
+	entities.CarStatusExported,
+}
+
+type InspectionServiceImpl struct {
+	inspectionRepo  repositories.InspectionRepository
+	carRepo         repositories.CarRepository
+	odometerService services.OdometerService
+}
+
+func NewInspectionService(
+	inspectionRepo repositories.InspectionRepository,
+	carRepo repositories.CarRepository,
+	odometerService services.OdometerService,
+) services.InspectionService {
+	return &InspectionServiceImpl{
+		inspectionRepo:  inspectionRepo,
+		carRepo:         carRepo,
+		odometerService: odometerService,
+	}
+}
+
+// CreateInspection registra una inspección y calcula el vencimiento de la próxima según el año
+// del auto y la categoría de su modelo. Si se informa el kilometraje se agrega al historial.
+func (s *InspectionServiceImpl) CreateInspection(ctx context.Context, record *entities.Inspection) (*entities.Inspection, error) {
+	car, err := s.carRepo.GetByIDWithRelations(ctx, record.CarID, repositories.CarRelations{Model: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(uninspectableStatuses, car.Status) {
+		return nil, errors.NewBusinessError("INSPECTION_NOT_ALLOWED",
+			fmt.Sprintf("No se puede inspeccionar un vehículo en estado %s", car.Status))
+	}
+
+	if record.Date.Year() < car.Year {
+		return nil, errors.NewBusinessError("INSPECTION_BEFORE_MANUFACTURE",
+			"La fecha de la inspección no puede ser anterior al año de fabricación del vehículo")
+	}
+
+	record.NextDueDate = inspection.NextDueDate(record.Date, record.Result, car.Year, car.Model.Category)
+	if record.Result != entities.InspectionResultApproved {
+		decisions.Record(ctx, fmt.Sprintf("Inspección %s: se requiere reinspección antes del %s",
+			record.Result, record.NextDueDate.Format(time.DateOnly)))
+	}
+
+	if err := s.inspectionRepo.Create(ctx, record); err != nil {
+		return nil, err
+	}
+
+	if record.Odometer != nil {
+		if err := s.odometerService.RecordSourceReading(ctx, inspectionOdometerReading(record)); err != nil {
+			return nil, err
+		}
+	}
+	return record, nil
+}
+
+func (s *InspectionServiceImpl) GetCarInspections(ctx context.Context, carID uuid.UUID) ([]*entities.Inspection, error) {
+	if _, err := s.carRepo.GetByID(ctx, carID); err != nil {
+		return nil, err
+	}
+	return s.inspectionRepo.ListByCarID(ctx, carID)
+}
+
+func (s *InspectionServiceImpl) GetInspection(ctx context.Context, carID, id uuid.UUID) (*entities.Inspection, error) {
+	return s.inspectionRepo.GetByID(ctx, carID, id)
+}
+
+// GetDueInspections combina los autos cuya última inspección vence en el período con los que nunca
+// fueron inspeccionados y deben realizar la primera inspección, ordenados por vencimiento
+func (s *InspectionServiceImpl) GetDueInspections(ctx context.Context, until time.Time) ([]*services.DueInspection, error) {
+	latest, err := s.inspectionRepo.ListLatestDue(ctx, until, uninspectableStatuses)
+	if err != nil {
+		return nil, err
+	}
+
+	due := make([]*services.DueInspection, 0, len(latest))
+	for _, last := range latest {
+		car := last.Car
+		due = append(due, &services.DueInspection{
+			Car:            &car,
+			LastInspection: last,
+			DueDate:        last.NextDueDate,
+		})
+	}
+
+	uninspected, err := s.inspectionRepo.ListUninspectedCars(ctx, uninspectableStatuses)
+	if err != nil {
+		return nil, err
+	}
+	for _, car := range uninspected {
+		dueDate := inspection.FirstDueDate(car.Year, car.Model.Category)
+		if dueDate.After(until) {
+			continue
+		}
+		due = append(due, &services.DueInspection{
+			Car:     car,
+			DueDate: dueDate,
+		})
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].DueDate.Before(due[j].DueDate)
+	})
+	return due, nil
+}
+
+func inspectionOdometerReading(record *entities.Inspection) *entities.OdometerReading {
+	return entities.NewOdometerReading(record.CarID, record.Date, *record.Odometer, entities.OdometerSourceInspection, &record.ID,
+		fmt.Sprintf("Inspección en %s", record.Station))
+}
@@ -0,0 +1,161 @@
+// internal/application/services/insurance_policy_service_implementation.go
+
+package services
+
+import (
+	"car-service/internal/domain/decisions"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/errors"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InsurancePolicyServiceImpl struct {
+	policyRepo repositories.InsurancePolicyRepository
+	carRepo    repositories.CarRepository
+	ownerRepo  repositories.OwnerRepository
+}
+
+func NewInsurancePolicyService(
+	policyRepo repositories.InsurancePolicyRepository,
+	carRepo repositories.CarRepository,
+	ownerRepo repositories.OwnerRepository,
+) services.InsurancePolicyService {
+	return &InsurancePolicyServiceImpl{
+		policyRepo: policyRepo,
+		carRepo:    carRepo,
+		ownerRepo:  ownerRepo,
+	}
+}
+
+// CreatePolicy registra una póliza contratada por el propietario actual del auto. Se rechaza si el
+// número ya existe para la aseguradora o si el período se superpone con otra póliza del auto.
+func (s *InsurancePolicyServiceImpl) CreatePolicy(ctx context.Context, policy *entities.InsurancePolicy) (*entities.InsurancePolicy, error) {
+	car, err := s.carRepo.GetByID(ctx, policy.CarID)
+	if err != nil {
+		return nil, err
+	}
+
+	if car.Status.IsFinal() {
+		return nil, errors.NewBusinessError("INSURANCE_NOT_ALLOWED",
+			fmt.Sprintf("No se puede asegurar un vehículo en estado %s", car.Status))
+	}
+
+	if policy.OwnerID == uuid.Nil {
+		policy.OwnerID = car.OwnerID
+	} else if policy.OwnerID != car.OwnerID {
+		return nil, errors.NewBusinessError("POLICY_HOLDER_NOT_OWNER",
+			"El titular de la póliza debe ser el propietario actual del vehículo")
+	}
+
+	existing, err := s.policyRepo.GetByNumber(ctx, policy.Insurer, policy.PolicyNumber)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.NewBusinessError("POLICY_NUMBER_TAKEN",
+			fmt.Sprintf("La aseguradora %s ya tiene registrada la póliza %s", policy.Insurer, policy.PolicyNumber))
+	}
+
+	overlapping, err := s.policyRepo.GetOverlapping(ctx, car.ID, policy.StartDate, policy.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if overlapping != nil {
+		return nil, errors.NewBusinessError("POLICY_OVERLAP",
+			fmt.Sprintf("El período se superpone con la póliza %s de %s (%s a %s)",
+				overlapping.PolicyNumber, overlapping.Insurer,
+				overlapping.StartDate.Format(time.DateOnly), overlapping.EffectiveEnd().Format(time.DateOnly)))
+	}
+
+	if err := s.policyRepo.Create(ctx, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// TerminatePolicy da de baja una póliza antes de su vencimiento. Una baja anterior al inicio
+// anula la póliza sin que haya brindado cobertura.
+func (s *InsurancePolicyServiceImpl) TerminatePolicy(ctx context.Context, carID, id uuid.UUID, date time.Time) (*entities.InsurancePolicy, error) {
+	policy, err := s.policyRepo.GetByID(ctx, carID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if policy.IsTerminated() {
+		return nil, errors.NewBusinessError("POLICY_ALREADY_TERMINATED", "La póliza ya fue dada de baja")
+	}
+
+	if !date.Before(policy.EndDate) {
+		return nil, errors.NewBusinessError("POLICY_ALREADY_EXPIRED", "La póliza vence antes de la fecha de baja indicada")
+	}
+
+	if date.Before(policy.StartDate) {
+		decisions.Record(ctx, "La baja es anterior al inicio de la póliza; la póliza queda anulada sin cobertura")
+	}
+
+	policy.Terminate(date)
+	if err := s.policyRepo.Update(ctx, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (s *InsurancePolicyServiceImpl) GetCarPolicies(ctx context.Context, carID uuid.UUID) ([]*entities.InsurancePolicy, error) {
+	if _, err := s.carRepo.GetByID(ctx, carID); err != nil {
+		return nil, err
+	}
+	return s.policyRepo.ListByCarID(ctx, carID)
+}
+
+func (s *InsurancePolicyServiceImpl) GetOwnerPolicies(ctx context.Context, ownerID uuid.UUID) ([]*entities.InsurancePolicy, error) {
+	if _, err := s.ownerRepo.GetByID(ctx, ownerID); err != nil {
+		return nil, err
+	}
+	return s.policyRepo.ListByOwnerID(ctx, ownerID)
+}
+
+// GetInsuranceAlerts retorna primero los autos sin seguro y luego los que pierden la cobertura
+// dentro del período, ordenados por vencimiento. Los autos en estado final se omiten.
+func (s *InsurancePolicyServiceImpl) GetInsuranceAlerts(ctx context.Context, at, until time.Time) ([]*services.InsuranceAlert, error) {
+	coverages, err := s.policyRepo.ListCoverageAlerts(ctx, at, until)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make([]*services.InsuranceAlert, len(coverages))
+	for i, coverage := range coverages {
+		alerts[i] = &services.InsuranceAlert{
+			Car:          coverage.Car,
+			Policy:       coverage.Policy,
+			CoveredUntil: coverage.CoveredUntil,
+		}
+	}
+	return alerts, nil
+}
+
+// terminateSellerPolicies da de baja, en la fecha de la transferencia, las pólizas vigentes o futuras
+// del auto contratadas por el propietario que lo vende
+func terminateSellerPolicies(ctx context.Context, policyRepo repositories.InsurancePolicyRepository, carID, sellerID uuid.UUID, date time.Time) error {
+	policies, err := policyRepo.ListUnexpiredByCarID(ctx, carID, date)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if policy.OwnerID != sellerID {
+			continue
+		}
+		policy.Terminate(date)
+		if err := policyRepo.Update(ctx, policy); err != nil {
+			return err
+		}
+		decisions.Record(ctx, fmt.Sprintf("Se dio de baja la póliza %s de %s del propietario anterior", policy.PolicyNumber, policy.Insurer))
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+// internal/application/services/model_service_implementation.go
+
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/errors"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ModelServiceImpl struct {
+	modelRepo repositories.ModelRepository
+	brandRepo repositories.BrandRepository
+	carRepo   repositories.CarRepository
+	trimRepo  repositories.ModelTrimRepository
+}
+
+func NewModelService(
+	modelRepo repositories.ModelRepository,
+	brandRepo repositories.BrandRepository,
+	carRepo repositories.CarRepository,
+	trimRepo repositories.ModelTrimRepository,
+) services.ModelService {
+	return &ModelServiceImpl{
+		modelRepo: modelRepo,
+		brandRepo: brandRepo,
+		carRepo:   carRepo,
+		trimRepo:  trimRepo,
+	}
+}
+
+func (s *ModelServiceImpl) CreateModel(ctx context.Context, model *entities.Model) (*entities.Model, error) {
+	if err := s.validateModel(ctx, model); err != nil {
+		return nil, err
+	}
+
+	if err := s.modelRepo.Create(ctx, model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+func (s *ModelServiceImpl) GetModels(ctx context.Context, filter services.ModelFilter) ([]*entities.Model, error) {
+	var models []*entities.Model
+	var err error
+	switch {
+	case !filter.Specs.IsEmpty():
+		models, err = s.modelRepo.ListBySpecs(ctx, filter.Specs)
+	case filter.BrandID != uuid.Nil:
+		models, err = s.modelRepo.GetByBrandID(ctx, filter.BrandID)
+	case filter.Category != "":
+		models, err = s.modelRepo.ListByCategory(ctx, filter.Category)
+	case filter.ActiveOnly:
+		models, err = s.modelRepo.ListActive(ctx)
+	default:
+		models, err = s.modelRepo.List(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Los criterios restantes se aplican sobre el resultado del repositorio
+	filtered := make([]*entities.Model, 0, len(models))
+	for _, model := range models {
+		if filter.BrandID != uuid.Nil && model.BrandID != filter.BrandID {
+			continue
+		}
+		if filter.Category != "" && !strings.EqualFold(model.Category, filter.Category) {
+			continue
+		}
+		if filter.ActiveOnly && !model.Active {
+			continue
+		}
+		filtered = append(filtered, model)
+	}
+	return filtered, nil
+}
+
+func (s *ModelServiceImpl) GetModel(ctx context.Context, id uuid.UUID) (*entities.Model, error) {
+	return s.modelRepo.GetByID(ctx, id)
+}
+
+func (s *ModelServiceImpl) GetBrandModels(ctx context.Context, brandID uuid.UUID) ([]*entities.Model, error) {
+	if _, err := s.brandRepo.GetByID(ctx, brandID); err != nil {
+		return nil, err
+	}
+	return s.modelRepo.GetByBrandID(ctx, brandID)
+}
+
+func (s *ModelServiceImpl) UpdateModel(ctx context.Context, model *entities.Model) (*entities.Model, error) {
+	existingModel, err := s.modelRepo.GetByID(ctx, model.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateModel(ctx, model); err != nil {
+		return nil, err
+	}
+
+	model.CreatedAt = existingModel.CreatedAt
+	if err := s.modelRepo.Update(ctx, model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+func (s *ModelServiceImpl) DeleteModel(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.modelRepo.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	cars, err := s.carRepo.CountByModelID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if cars > 0 {
+		return errors.NewBusinessError("MODEL_HAS_CARS", "No se puede eliminar un modelo con vehículos asociados; desactívelo en su lugar")
+	}
+
+	return s.modelRepo.Delete(ctx, id)
+}
+
+func (s *ModelServiceImpl) CreateTrim(ctx context.Context, trim *entities.ModelTrim) (*entities.ModelTrim, error) {
+	if _, err := s.modelRepo.GetByID(ctx, trim.ModelID); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateTrim(ctx, trim); err != nil {
+		return nil, err
+	}
+
+	if err := s.trimRepo.Create(ctx, trim); err != nil {
+		return nil, err
+	}
+	return trim, nil
+}
+
+func (s *ModelServiceImpl) GetModelTrims(ctx context.Context, modelID uuid.UUID) ([]*entities.ModelTrim, error) {
+	if _, err := s.modelRepo.GetByID(ctx, modelID); err != nil {
+		return nil, err
+	}
+	return s.trimRepo.ListByModelID(ctx, modelID)
+}
+
+func (s *ModelServiceImpl) GetModelTrim(ctx context.Context, modelID, id uuid.UUID) (*entities.ModelTrim, error) {
+	return s.trimRepo.GetByID(ctx, modelID, id)
+}
+
+func (s *ModelServiceImpl) UpdateTrim(ctx context.Context, trim *entities.ModelTrim) (*entities.ModelTrim, error) {
+	existingTrim, err := s.trimRepo.GetByID(ctx, trim.ModelID, trim.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateTrim(ctx, trim); err != nil {
+		return nil, err
+	}
+
+	trim.CreatedAt = existingTrim.CreatedAt
+	trim.UpdatedAt = time.Now()
+	if err := s.trimRepo.Update(ctx, trim); err != nil {
+		return nil, err
+	}
+	return trim, nil
+}
+
+func (s *ModelServiceImpl) DeleteTrim(ctx context.Context, modelID, id uuid.UUID) error {
+	if _, err := s.trimRepo.GetByID(ctx, modelID, id); err != nil {
+		return err
+	}
+
+	cars, err := s.carRepo.CountByTrimID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if cars > 0 {
+		return errors.NewBusinessError("TRIM_HAS_CARS", "No se puede eliminar una versión con vehículos asociados")
+	}
+
+	return s.trimRepo.Delete(ctx, id)
+}
+
+// validateTrim verifica que el nombre de la versión sea único dentro del modelo
+func (s *ModelServiceImpl) validateTrim(ctx context.Context, trim *entities.ModelTrim) error {
+	existingTrim, err := s.trimRepo.GetByNameAndModel(ctx, trim.Name, trim.ModelID)
+	if err != nil {
+		return err
+	}
+	if existingTrim != nil && existingTrim.ID != trim.ID {
+		return errors.NewBusinessError("DUPLICATE_TRIM", "Ya existe una versión con este nombre para el modelo")
+	}
+	return nil
+}
+
+// validateModel verifica las reglas del catálogo: la marca existe, el par (nombre, marca) es único
+// y el rango de producción es coherente
+func (s *ModelServiceImpl) validateModel(ctx context.Context, model *entities.Model) error {
+	if model.EndYear != 0 && model.EndYear < model.StartYear {
+		return errors.NewBusinessError("INVALID_PRODUCTION_RANGE", "El año de fin de producción debe ser 0 o mayor o igual al año de inicio")
+	}
+
+	if _, err := s.brandRepo.GetByID(ctx, model.BrandID); err != nil {
+		return errors.NewBusinessError("BRAND_NOT_FOUND", "La marca especificada no existe")
+	}
+
+	existingModel, err := s.modelRepo.GetByNameAndBrand(ctx, model.Name, model.BrandID)
+	if err == nil && existingModel != nil && existingModel.ID != model.ID {
+		return errors.NewBusinessError("DUPLICATE_MODEL", "Ya existe un modelo con este nombre para la marca")
+	}
+	return nil
+}
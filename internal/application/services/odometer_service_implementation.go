@@ -0,0 +1,154 @@
+// internal/application/services/odometer_service_implementation.go
+
+package services
+
+import (
+	"car-service/internal/domain/decisions"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/odometer"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OdometerServiceImpl struct {
+	readingRepo repositories.OdometerReadingRepository
+	carRepo     repositories.CarRepository
+}
+
+func NewOdometerService(
+	readingRepo repositories.OdometerReadingRepository,
+	carRepo repositories.CarRepository,
+) services.OdometerService {
+	return &OdometerServiceImpl{
+		readingRepo: readingRepo,
+		carRepo:     carRepo,
+	}
+}
+
+// RecordReading registra una lectura manual. Las lecturas inconsistentes no se rechazan:
+// se guardan marcadas con la anomalía detectada y el auto queda señalado.
+func (s *OdometerServiceImpl) RecordReading(ctx context.Context, reading *entities.OdometerReading) (*entities.OdometerReading, error) {
+	car, err := s.carRepo.GetByID(ctx, reading.CarID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.readingRepo.Create(ctx, reading); err != nil {
+		return nil, err
+	}
+
+	readings, err := s.analyze(ctx, car)
+	if err != nil {
+		return nil, err
+	}
+	for _, analyzed := range readings {
+		if analyzed.ID == reading.ID {
+			return analyzed, nil
+		}
+	}
+	return reading, nil
+}
+
+// RecordSourceReading crea o actualiza la lectura asociada a un servicio o una inspección
+func (s *OdometerServiceImpl) RecordSourceReading(ctx context.Context, reading *entities.OdometerReading) error {
+	car, err := s.carRepo.GetByID(ctx, reading.CarID)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.readingRepo.GetBySource(ctx, reading.Source, *reading.SourceID)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		err = s.readingRepo.Create(ctx, reading)
+	} else {
+		existing.Date = reading.Date
+		existing.Value = reading.Value
+		existing.Notes = reading.Notes
+		existing.UpdatedAt = time.Now()
+		err = s.readingRepo.Update(ctx, existing)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = s.analyze(ctx, car)
+	return err
+}
+
+// RemoveSourceReading elimina la lectura asociada a un servicio o una inspección eliminados
+func (s *OdometerServiceImpl) RemoveSourceReading(ctx context.Context, carID uuid.UUID, source entities.OdometerSource, sourceID uuid.UUID) error {
+	car, err := s.carRepo.GetByID(ctx, carID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.readingRepo.DeleteBySource(ctx, source, sourceID); err != nil {
+		return err
+	}
+
+	_, err = s.analyze(ctx, car)
+	return err
+}
+
+func (s *OdometerServiceImpl) GetOdometerHistory(ctx context.Context, carID uuid.UUID) (*services.OdometerHistory, error) {
+	car, err := s.carRepo.GetByID(ctx, carID)
+	if err != nil {
+		return nil, err
+	}
+
+	readings, err := s.readingRepo.ListByCarID(ctx, carID)
+	if err != nil {
+		return nil, err
+	}
+	return &services.OdometerHistory{Car: car, Readings: readings}, nil
+}
+
+// analyze recalcula las anomalías de toda la serie del auto, persiste las lecturas cuyo
+// estado cambió y actualiza la marca del auto
+func (s *OdometerServiceImpl) analyze(ctx context.Context, car *entities.Car) ([]*entities.OdometerReading, error) {
+	readings, err := s.readingRepo.ListByCarID(ctx, car.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := make(map[uuid.UUID]entities.OdometerAnomaly, len(readings))
+	for _, reading := range readings {
+		previous[reading.ID] = reading.Anomaly
+	}
+
+	flagged := odometer.Analyze(readings, car.Year)
+	for _, reading := range readings {
+		if reading.Anomaly == previous[reading.ID] {
+			continue
+		}
+		if reading.Anomaly != entities.OdometerAnomalyNone {
+			decisions.Record(ctx, fmt.Sprintf("La lectura de %d km del %s se marcó como anomalía (%s)",
+				reading.Value, reading.Date.Format(time.DateOnly), reading.Anomaly))
+		}
+		if err := s.readingRepo.Update(ctx, reading); err != nil {
+			return nil, err
+		}
+	}
+
+	if car.OdometerFlagged != flagged {
+		car.OdometerFlagged = flagged
+		car.UpdatedAt = time.Now()
+		if err := s.carRepo.Update(ctx, car); err != nil {
+			return nil, err
+		}
+		if flagged {
+			decisions.Record(ctx, "El vehículo quedó señalado por inconsistencias en su kilometraje")
+		} else {
+			decisions.Record(ctx, "El historial de kilometraje del vehículo ya no presenta anomalías")
+		}
+	}
+	return readings, nil
+}
@@ -0,0 +1,135 @@
+package services_test
+
+import (
+	"car-service/internal/application/services"
+	"car-service/internal/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestRecordReadingDetectsAnomalies(t *testing.T) {
+	type reading struct {
+		date  time.Time
+		value int
+	}
+
+	tests := []struct {
+		name      string
+		existing  []reading
+		recorded  reading
+		anomalies []entities.OdometerAnomaly // Anomalía de cada lectura: las existentes y luego la registrada
+		flagged   bool
+		decision  string
+	}{
+		{
+			name:      "serie creciente",
+			existing:  []reading{{date(2021, time.January, 1), 10000}, {date(2022, time.January, 1), 25000}},
+			recorded:  reading{date(2023, time.January, 1), 40000},
+			anomalies: []entities.OdometerAnomaly{"", "", ""},
+		},
+		{
+			name:      "retroceso",
+			existing:  []reading{{date(2021, time.January, 1), 10000}, {date(2022, time.January, 1), 25000}},
+			recorded:  reading{date(2023, time.January, 1), 20000},
+			anomalies: []entities.OdometerAnomaly{"", "", entities.OdometerAnomalyDecrease},
+			flagged:   true,
+			decision:  "20000 km",
+		},
+		{
+			name:      "salto implausible",
+			existing:  []reading{{date(2021, time.January, 1), 10000}},
+			recorded:  reading{date(2021, time.February, 1), 60000},
+			anomalies: []entities.OdometerAnomaly{"", entities.OdometerAnomalyImplausibleJump},
+			flagged:   true,
+			decision:  "implausible_jump",
+		},
+		{
+			name:      "primera lectura implausible para el año de fabricación",
+			recorded:  reading{date(2020, time.March, 1), 90000},
+			anomalies: []entities.OdometerAnomaly{entities.OdometerAnomalyImplausibleJump},
+			flagged:   true,
+		},
+		{
+			name:      "lectura intermedia que deja como retroceso a la posterior",
+			existing:  []reading{{date(2021, time.January, 1), 10000}, {date(2022, time.January, 1), 25000}},
+			recorded:  reading{date(2021, time.July, 1), 30000},
+			anomalies: []entities.OdometerAnomaly{"", entities.OdometerAnomalyDecrease, ""},
+			flagged:   true,
+			decision:  "25000 km",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			car := entities.NewCar(uuid.New(), 2020, "Rojo", "JTDBR32E4L0123456", uuid.New())
+			repo := &fakeOdometerRepo{}
+			for _, existing := range tt.existing {
+				repo.readings = append(repo.readings, entities.NewOdometerReading(car.ID, existing.date, existing.value, entities.OdometerSourceManual, nil, ""))
+			}
+			service := services.NewOdometerService(repo, newFakeCarRepo(car))
+			ctx, log := newDecisionContext()
+
+			recorded := entities.NewOdometerReading(car.ID, tt.recorded.date, tt.recorded.value, entities.OdometerSourceManual, nil, "")
+			if _, err := service.RecordReading(ctx, recorded); err != nil {
+				t.Fatalf("error inesperado: %v", err)
+			}
+
+			if len(repo.readings) != len(tt.anomalies) {
+				t.Fatalf("lecturas = %d, se esperaban %d", len(repo.readings), len(tt.anomalies))
+			}
+			for i, reading := range repo.readings {
+				if reading.Anomaly != tt.anomalies[i] {
+					t.Errorf("lectura de %d km del %s: anomalía = %q, se esperaba %q",
+						reading.Value, reading.Date.Format(time.DateOnly), reading.Anomaly, tt.anomalies[i])
+				}
+			}
+
+			if car.OdometerFlagged != tt.flagged {
+				t.Errorf("auto señalado = %v, se esperaba %v", car.OdometerFlagged, tt.flagged)
+			}
+			if tt.flagged && !containsDecision(log.All(), "quedó señalado") {
+				t.Errorf("decisiones = %v, se esperaba el señalamiento del auto", log.All())
+			}
+			if tt.decision != "" && !containsDecision(log.All(), tt.decision) {
+				t.Errorf("decisiones = %v, se esperaba una con %q", log.All(), tt.decision)
+			}
+		})
+	}
+}
+
+func TestRemoveSourceReadingClearsAnomalies(t *testing.T) {
+	car := entities.NewCar(uuid.New(), 2020, "Rojo", "JTDBR32E4L0123456", uuid.New())
+	serviceID := uuid.New()
+	repo := &fakeOdometerRepo{readings: []*entities.OdometerReading{
+		entities.NewOdometerReading(car.ID, date(2021, time.January, 1), 10000, entities.OdometerSourceManual, nil, ""),
+		entities.NewOdometerReading(car.ID, date(2021, time.June, 1), 30000, entities.OdometerSourceService, &serviceID, ""),
+		entities.NewOdometerReading(car.ID, date(2022, time.January, 1), 25000, entities.OdometerSourceManual, nil, ""),
+	}}
+	service := services.NewOdometerService(repo, newFakeCarRepo(car))
+	ctx, log := newDecisionContext()
+
+	// La lectura del servicio deja como retroceso a la de 2022
+	latest := entities.NewOdometerReading(car.ID, date(2023, time.January, 1), 35000, entities.OdometerSourceManual, nil, "")
+	if _, err := service.RecordReading(ctx, latest); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if !car.OdometerFlagged || repo.readings[2].Anomaly != entities.OdometerAnomalyDecrease {
+		t.Fatalf("se esperaba el auto señalado por el retroceso de la lectura de 2022")
+	}
+
+	if err := service.RemoveSourceReading(ctx, car.ID, entities.OdometerSourceService, serviceID); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	for _, reading := range repo.readings {
+		if reading.Anomaly != entities.OdometerAnomalyNone {
+			t.Errorf("lectura de %d km: anomalía = %q, se esperaba ninguna", reading.Value, reading.Anomaly)
+		}
+	}
+	if car.OdometerFlagged {
+		t.Error("el auto no debería seguir señalado")
+	}
+	if !containsDecision(log.All(), "ya no presenta anomalías") {
+		t.Errorf("decisiones = %v, se esperaba el fin del señalamiento", log.All())
+	}
+}
@@ -0,0 +1,96 @@
+// internal/application/services/owner_service_implementation.go
+
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/errors"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type OwnerServiceImpl struct {
+	ownerRepo repositories.OwnerRepository
+	carRepo   repositories.CarRepository
+}
+
+func NewOwnerService(
+	ownerRepo repositories.OwnerRepository,
+	carRepo repositories.CarRepository,
+) services.OwnerService {
+	return &OwnerServiceImpl{
+		ownerRepo: ownerRepo,
+		carRepo:   carRepo,
+	}
+}
+
+func (s *OwnerServiceImpl) CreateOwner(ctx context.Context, owner *entities.Owner) (*entities.Owner, error) {
+	if err := s.validateUniqueEmail(ctx, owner); err != nil {
+		return nil, err
+	}
+
+	if err := s.ownerRepo.Create(ctx, owner); err != nil {
+		return nil, err
+	}
+	return owner, nil
+}
+
+func (s *OwnerServiceImpl) GetOwners(ctx context.Context) ([]*entities.Owner, error) {
+	return s.ownerRepo.List(ctx)
+}
+
+func (s *OwnerServiceImpl) GetOwner(ctx context.Context, id uuid.UUID) (*entities.Owner, error) {
+	return s.ownerRepo.GetByID(ctx, id)
+}
+
+func (s *OwnerServiceImpl) UpdateOwner(ctx context.Context, owner *entities.Owner) (*entities.Owner, error) {
+	existingOwner, err := s.ownerRepo.GetByID(ctx, owner.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateUniqueEmail(ctx, owner); err != nil {
+		return nil, err
+	}
+
+	owner.CreatedAt = existingOwner.CreatedAt
+	if err := s.ownerRepo.Update(ctx, owner); err != nil {
+		return nil, err
+	}
+	return owner, nil
+}
+
+func (s *OwnerServiceImpl) DeleteOwner(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.ownerRepo.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	cars, err := s.carRepo.GetByOwnerID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(cars) > 0 {
+		return errors.NewBusinessError("OWNER_HAS_CARS", "No se puede eliminar un propietario con vehículos asignados")
+	}
+
+	return s.ownerRepo.Delete(ctx, id)
+}
+
+func (s *OwnerServiceImpl) GetOwnerCars(ctx context.Context, id uuid.UUID) ([]*entities.Car, error) {
+	if _, err := s.ownerRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.carRepo.GetByOwnerID(ctx, id)
+}
+
+// validateUniqueEmail verifica que ningún otro propietario utilice el email
+func (s *OwnerServiceImpl) validateUniqueEmail(ctx context.Context, owner *entities.Owner) error {
+	existingOwner, err := s.ownerRepo.GetByEmail(ctx, owner.Email)
+	if err == nil && existingOwner != nil && existingOwner.ID != owner.ID {
+		return errors.NewBusinessError("DUPLICATE_EMAIL", "Ya existe un propietario con este email")
+	}
+	return nil
+}
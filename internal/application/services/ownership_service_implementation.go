@@ -0,0 +1,158 @@
+// internal/application/services/ownership_service_implementation.go
+
+package services
+
+import (
+	"car-service/internal/domain/decisions"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/errors"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// transferableStatuses son los estados en los que un vehículo puede cambiar de propietario
+var transferableStatuses = map[entities.CarStatus]bool{
+	entities.CarStatusRegistered: true,
+	entities.CarStatusInService:  true,
+	entities.CarStatusForSale:    true,
+	entities.CarStatusSold:       true,
+}
+
+type OwnershipServiceImpl struct {
+	ownershipRepo repositories.OwnershipRecordRepository
+	carRepo       repositories.CarRepository
+	ownerRepo     repositories.OwnerRepository
+	statusRepo    repositories.CarStatusTransitionRepository
+	stolenRepo    repositories.StolenReportRepository
+	policyRepo    repositories.InsurancePolicyRepository
+}
+
+func NewOwnershipService(
+	ownershipRepo repositories.OwnershipRecordRepository,
+	carRepo repositories.CarRepository,
+	ownerRepo repositories.OwnerRepository,
+	statusRepo repositories.CarStatusTransitionRepository,
+	stolenRepo repositories.StolenReportRepository,
+	policyRepo repositories.InsurancePolicyRepository,
+) services.OwnershipService {
+	return &OwnershipServiceImpl{
+		ownershipRepo: ownershipRepo,
+		carRepo:       carRepo,
+		ownerRepo:     ownerRepo,
+		statusRepo:    statusRepo,
+		stolenRepo:    stolenRepo,
+		policyRepo:    policyRepo,
+	}
+}
+
+// TransferOwnership cierra el registro de propiedad vigente y abre uno nuevo para el comprador.
+// Las pólizas de seguro del vendedor se dan de baja en la fecha de la transferencia. Se ejecuta
+// dentro de la transacción del command, por lo que los registros, las pólizas y el auto se
+// actualizan de forma atómica.
+func (s *OwnershipServiceImpl) TransferOwnership(ctx context.Context, transfer services.OwnershipTransfer) (*entities.OwnershipRecord, error) {
+	car, err := s.carRepo.GetByID(ctx, transfer.CarID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkWatchlist(ctx, s.stolenRepo, car.VIN); err != nil {
+		return nil, err
+	}
+
+	if !transferableStatuses[car.Status] {
+		return nil, errors.NewBusinessError("CAR_STATUS_NOT_TRANSFERABLE",
+			fmt.Sprintf("No se puede transferir un vehículo en estado %s", car.Status))
+	}
+
+	if car.OwnerID == transfer.OwnerID {
+		return nil, errors.NewBusinessError("SAME_OWNER", "El vehículo ya pertenece al propietario indicado")
+	}
+
+	ownerExists, err := s.ownerRepo.ExistsByID(ctx, transfer.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	if !ownerExists {
+		return nil, errors.NewBusinessError("OWNER_NOT_FOUND", "El propietario especificado no existe")
+	}
+
+	current, err := s.currentRecord(ctx, car)
+	if err != nil {
+		return nil, err
+	}
+
+	if transfer.Date.Before(current.From) {
+		return nil, errors.NewBusinessError("TRANSFER_DATE_BEFORE_CURRENT_OWNERSHIP",
+			fmt.Sprintf("La fecha de transferencia es anterior al inicio de la titularidad vigente (%s)", current.From.Format(time.DateOnly)))
+	}
+
+	current.Close(transfer.Date)
+	if err := s.ownershipRepo.Update(ctx, current); err != nil {
+		return nil, err
+	}
+
+	if err := terminateSellerPolicies(ctx, s.policyRepo, car.ID, current.OwnerID, transfer.Date); err != nil {
+		return nil, err
+	}
+
+	record := entities.NewOwnershipRecord(car.ID, transfer.OwnerID, transfer.Date, transfer.Price, transfer.Notes)
+	if err := s.ownershipRepo.Create(ctx, record); err != nil {
+		return nil, err
+	}
+
+	car.OwnerID = transfer.OwnerID
+	car.UpdatedAt = time.Now()
+
+	// Un vehículo publicado o vendido vuelve a estar registrado a nombre del comprador
+	var transition *entities.CarStatusTransition
+	if car.Status == entities.CarStatusForSale || car.Status == entities.CarStatusSold {
+		transition, err = car.ChangeStatus(entities.CarStatusRegistered, "Transferencia de titularidad")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.carRepo.Update(ctx, car); err != nil {
+		return nil, err
+	}
+
+	if transition != nil {
+		if err := s.statusRepo.Create(ctx, transition); err != nil {
+			return nil, err
+		}
+	}
+
+	decisions.Record(ctx, fmt.Sprintf("Se cerró la titularidad de %s y se abrió la de %s", current.OwnerID, transfer.OwnerID))
+	return record, nil
+}
+
+func (s *OwnershipServiceImpl) GetOwnershipHistory(ctx context.Context, carID uuid.UUID) ([]*entities.OwnershipRecord, error) {
+	if _, err := s.carRepo.GetByID(ctx, carID); err != nil {
+		return nil, err
+	}
+	return s.ownershipRepo.ListByCarID(ctx, carID)
+}
+
+// currentRecord obtiene el registro vigente del auto. Los autos sin historial registrado
+// reciben un registro que comienza en su fecha de alta.
+func (s *OwnershipServiceImpl) currentRecord(ctx context.Context, car *entities.Car) (*entities.OwnershipRecord, error) {
+	current, err := s.ownershipRepo.GetCurrentByCarID(ctx, car.ID)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil {
+		return current, nil
+	}
+
+	current = entities.NewOwnershipRecord(car.ID, car.OwnerID, car.CreatedAt, nil, "")
+	if err := s.ownershipRepo.Create(ctx, current); err != nil {
+		return nil, err
+	}
+	decisions.Record(ctx, "El vehículo no tenía historial de propietarios; se registró la titularidad actual desde su fecha de alta")
+	return current, nil
+}
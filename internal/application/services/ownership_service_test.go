@@ -0,0 +1,159 @@
+package services_test
+
+import (
+	"car-service/internal/application/services"
+	"car-service/internal/domain/entities"
+	domainservices "car-service/internal/domain/services"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ownershipFixture arma el servicio de titularidad con un auto de Ana y un comprador, Bruno
+type ownershipFixture struct {
+	service   domainservices.OwnershipService
+	car       *entities.Car
+	seller    *entities.Owner
+	buyer     *entities.Owner
+	ownership *fakeOwnershipRepo
+	status    *fakeStatusRepo
+	stolen    *fakeStolenRepo
+	policies  *fakePolicyRepo
+}
+
+func newOwnershipFixture(status entities.CarStatus) *ownershipFixture {
+	seller := entities.NewOwner("Ana", "ana@example.com", "", "")
+	buyer := entities.NewOwner("Bruno", "bruno@example.com", "", "")
+	car := entities.NewCar(uuid.New(), 2020, "Rojo", "JTDBR32E4L0123456", seller.ID)
+	car.Status = status
+
+	f := &ownershipFixture{
+		car:       car,
+		seller:    seller,
+		buyer:     buyer,
+		ownership: &fakeOwnershipRepo{records: []*entities.OwnershipRecord{entities.NewOwnershipRecord(car.ID, seller.ID, date(2020, time.March, 1), nil, "")}},
+		status:    &fakeStatusRepo{},
+		stolen:    &fakeStolenRepo{},
+		policies:  &fakePolicyRepo{},
+	}
+	f.service = services.NewOwnershipService(f.ownership, newFakeCarRepo(car), newFakeOwnerRepo(seller, buyer), f.status, f.stolen, f.policies)
+	return f
+}
+
+func (f *ownershipFixture) transfer(on time.Time) domainservices.OwnershipTransfer {
+	return domainservices.OwnershipTransfer{CarID: f.car.ID, OwnerID: f.buyer.ID, Date: on}
+}
+
+func TestTransferOwnershipByCarStatus(t *testing.T) {
+	tests := []struct {
+		status entities.CarStatus
+		code   string
+		after  entities.CarStatus
+	}{
+		{status: entities.CarStatusRegistered, after: entities.CarStatusRegistered},
+		{status: entities.CarStatusInService, after: entities.CarStatusInService},
+		{status: entities.CarStatusForSale, after: entities.CarStatusRegistered},
+		{status: entities.CarStatusSold, after: entities.CarStatusRegistered},
+		{status: entities.CarStatusStolen, code: "CAR_STATUS_NOT_TRANSFERABLE", after: entities.CarStatusStolen},
+		{status: entities.CarStatusScrapped, code: "CAR_STATUS_NOT_TRANSFERABLE", after: entities.CarStatusScrapped},
+		{status: entities.CarStatusExported, code: "CAR_STATUS_NOT_TRANSFERABLE", after: entities.CarStatusExported},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			f := newOwnershipFixture(tt.status)
+
+			record, err := f.service.TransferOwnership(context.Background(), f.transfer(date(2024, time.June, 1)))
+			if code := businessCode(err); code != tt.code {
+				t.Fatalf("código = %q (%v), se esperaba %q", code, err, tt.code)
+			}
+			if tt.code == "" && err != nil {
+				t.Fatalf("error inesperado: %v", err)
+			}
+
+			if f.car.Status != tt.after {
+				t.Errorf("estado = %s, se esperaba %s", f.car.Status, tt.after)
+			}
+			if tt.code != "" {
+				if f.car.OwnerID != f.seller.ID || len(f.ownership.records) != 1 || f.ownership.records[0].To != nil {
+					t.Errorf("una transferencia rechazada no debería modificar la titularidad")
+				}
+				return
+			}
+			if f.car.OwnerID != f.buyer.ID || record.OwnerID != f.buyer.ID {
+				t.Errorf("propietario = %s, se esperaba el comprador", f.car.OwnerID)
+			}
+			if closed := f.ownership.records[0]; closed.To == nil || !closed.To.Equal(date(2024, time.June, 1)) {
+				t.Errorf("la titularidad anterior debería cerrarse en la fecha de la transferencia: %v", closed.To)
+			}
+			if changed := tt.after != tt.status; changed != (len(f.status.transitions) == 1) {
+				t.Errorf("transiciones registradas = %d", len(f.status.transitions))
+			}
+		})
+	}
+}
+
+func TestTransferOwnershipChecksWatchlist(t *testing.T) {
+	recovered := date(2023, time.January, 10)
+	tests := []struct {
+		name      string
+		recovered *time.Time
+		code      string
+	}{
+		{name: "denuncia vigente", code: "VIN_REPORTED_STOLEN"},
+		{name: "denuncia con recupero", recovered: &recovered},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// El auto conserva su estado para comprobar que la lista de vigilancia se consulta por VIN
+			f := newOwnershipFixture(entities.CarStatusRegistered)
+			report := entities.NewStolenReport(f.car.VIN, nil, date(2023, time.January, 1), "DEN-1", "")
+			report.RecoveredDate = tt.recovered
+			f.stolen.reports = append(f.stolen.reports, report)
+
+			_, err := f.service.TransferOwnership(context.Background(), f.transfer(date(2024, time.June, 1)))
+			if code := businessCode(err); code != tt.code {
+				t.Fatalf("código = %q (%v), se esperaba %q", code, err, tt.code)
+			}
+			if tt.code == "" && err != nil {
+				t.Fatalf("error inesperado: %v", err)
+			}
+		})
+	}
+}
+
+func TestTransferOwnershipTerminatesSellerPolicies(t *testing.T) {
+	f := newOwnershipFixture(entities.CarStatusRegistered)
+	transferDate := date(2024, time.June, 1)
+	newPolicy := func(owner *entities.Owner, number string, start, end time.Time) *entities.InsurancePolicy {
+		return entities.NewInsurancePolicy(f.car.ID, owner.ID, "Aseguradora", number, entities.CoverageTypeLiability, start, end, 100, "")
+	}
+	current := newPolicy(f.seller, "VIGENTE", date(2024, time.January, 1), date(2025, time.January, 1))
+	renewal := newPolicy(f.seller, "RENOVACION", date(2025, time.January, 1), date(2026, time.January, 1))
+	expired := newPolicy(f.seller, "VENCIDA", date(2023, time.January, 1), date(2024, time.January, 1))
+	buyers := newPolicy(f.buyer, "COMPRADOR", date(2024, time.June, 1), date(2025, time.June, 1))
+	f.policies.policies = []*entities.InsurancePolicy{current, renewal, expired, buyers}
+
+	ctx, log := newDecisionContext()
+	if _, err := f.service.TransferOwnership(ctx, f.transfer(transferDate)); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+
+	for _, policy := range []*entities.InsurancePolicy{current, renewal} {
+		if policy.TerminatedAt == nil || !policy.TerminatedAt.Equal(transferDate) {
+			t.Errorf("la póliza %s del vendedor debería darse de baja en la fecha de la transferencia: %v", policy.PolicyNumber, policy.TerminatedAt)
+		}
+		if !containsDecision(log.All(), policy.PolicyNumber) {
+			t.Errorf("decisiones = %v, se esperaba la baja de %s", log.All(), policy.PolicyNumber)
+		}
+	}
+	for _, policy := range []*entities.InsurancePolicy{expired, buyers} {
+		if policy.IsTerminated() {
+			t.Errorf("la póliza %s no debería darse de baja", policy.PolicyNumber)
+		}
+	}
+	if len(f.policies.updated) != 2 {
+		t.Errorf("pólizas actualizadas = %d, se esperaba 2", len(f.policies.updated))
+	}
+}
@@ -0,0 +1,198 @@
+// internal/application/services/recall_service_implementation.go
+
+package services
+
+import (
+	"car-service/internal/domain/decisions"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/errors"
+	"car-service/internal/domain/recall"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"car-service/internal/domain/vin"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type RecallServiceImpl struct {
+	recallRepo    repositories.RecallRepository
+	carRecallRepo repositories.CarRecallRepository
+	carRepo       repositories.CarRepository
+	modelRepo     repositories.ModelRepository
+}
+
+func NewRecallService(
+	recallRepo repositories.RecallRepository,
+	carRecallRepo repositories.CarRecallRepository,
+	carRepo repositories.CarRepository,
+	modelRepo repositories.ModelRepository,
+) services.RecallService {
+	return &RecallServiceImpl{
+		recallRepo:    recallRepo,
+		carRecallRepo: carRecallRepo,
+		carRepo:       carRepo,
+		modelRepo:     modelRepo,
+	}
+}
+
+// CreateRecall registra la campaña y vincula los autos del modelo que alcanza. Los autos en
+// estado final no se vinculan porque ya no circulan.
+func (s *RecallServiceImpl) CreateRecall(ctx context.Context, record *entities.Recall) (*services.RecallDetail, error) {
+	modelExists, err := s.modelRepo.ExistsByID(ctx, record.ModelID)
+	if err != nil {
+		return nil, err
+	}
+	if !modelExists {
+		return nil, errors.NewBusinessError("MODEL_NOT_FOUND", "El modelo especificado no existe")
+	}
+
+	existing, err := s.recallRepo.GetByCampaign(ctx, record.Campaign)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.NewBusinessError("RECALL_CAMPAIGN_EXISTS",
+			fmt.Sprintf("La campaña %s ya está registrada", record.Campaign))
+	}
+
+	record.VINFrom = vin.Normalize(record.VINFrom)
+	record.VINTo = vin.Normalize(record.VINTo)
+	if err := s.recallRepo.Create(ctx, record); err != nil {
+		return nil, err
+	}
+
+	cars, err := s.carRepo.ListByModelYears(ctx, record.ModelID, record.YearFrom, record.YearTo)
+	if err != nil {
+		return nil, err
+	}
+
+	var affected []*entities.CarRecall
+	for _, car := range cars {
+		if !car.Status.IsFinal() && recall.Affects(record, car) {
+			affected = append(affected, entities.NewCarRecall(record.ID, car.ID))
+		}
+	}
+	if err := s.carRecallRepo.CreateBatch(ctx, affected); err != nil {
+		return nil, err
+	}
+
+	decisions.Record(ctx, fmt.Sprintf("La campaña %s alcanza a %d vehículos registrados", record.Campaign, len(affected)))
+	return &services.RecallDetail{Recall: record, Affected: len(affected)}, nil
+}
+
+func (s *RecallServiceImpl) GetRecall(ctx context.Context, id uuid.UUID) (*services.RecallDetail, error) {
+	record, err := s.recallRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := s.carRecallRepo.ListByRecallID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &services.RecallDetail{Recall: record, Affected: len(affected)}
+	for _, carRecall := range affected {
+		if !carRecall.IsOpen() {
+			detail.Remedied++
+		}
+	}
+	return detail, nil
+}
+
+func (s *RecallServiceImpl) GetAffectedCars(ctx context.Context, recallID uuid.UUID, status entities.RecallRemedyStatus) ([]*entities.CarRecall, error) {
+	if _, err := s.recallRepo.GetByID(ctx, recallID); err != nil {
+		return nil, err
+	}
+
+	carRecalls, err := s.carRecallRepo.ListByRecallID(ctx, recallID)
+	if err != nil {
+		return nil, err
+	}
+	return filterByRemedyStatus(carRecalls, status), nil
+}
+
+func (s *RecallServiceImpl) GetCarRecalls(ctx context.Context, carID uuid.UUID, status entities.RecallRemedyStatus) ([]*entities.CarRecall, error) {
+	if _, err := s.carRepo.GetByID(ctx, carID); err != nil {
+		return nil, err
+	}
+
+	carRecalls, err := s.carRecallRepo.ListByCarID(ctx, carID)
+	if err != nil {
+		return nil, err
+	}
+	return filterByRemedyStatus(carRecalls, status), nil
+}
+
+// CompleteRemedy registra que el vehículo recibió la reparación de la campaña
+func (s *RecallServiceImpl) CompleteRemedy(ctx context.Context, remedy services.RecallRemedy) (*entities.CarRecall, error) {
+	carRecall, err := s.carRecallRepo.GetByCarAndRecall(ctx, remedy.CarID, remedy.RecallID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !carRecall.IsOpen() {
+		return nil, errors.NewBusinessError("RECALL_ALREADY_REMEDIED", "La reparación de la campaña ya fue registrada para el vehículo")
+	}
+
+	if remedy.Date.Before(carRecall.Recall.IssuedAt) {
+		return nil, errors.NewBusinessError("REMEDY_BEFORE_RECALL", "La fecha de reparación no puede ser anterior a la emisión de la campaña")
+	}
+
+	carRecall.Remedy(remedy.Date, remedy.Workshop, remedy.Notes)
+	if err := s.carRecallRepo.Update(ctx, carRecall); err != nil {
+		return nil, err
+	}
+	return carRecall, nil
+}
+
+func filterByRemedyStatus(carRecalls []*entities.CarRecall, status entities.RecallRemedyStatus) []*entities.CarRecall {
+	if status == "" {
+		return carRecalls
+	}
+
+	filtered := make([]*entities.CarRecall, 0, len(carRecalls))
+	for _, carRecall := range carRecalls {
+		if carRecall.RemedyStatus() == status {
+			filtered = append(filtered, carRecall)
+		}
+	}
+	return filtered
+}
+
+// matchCarRecalls vincula el auto con las campañas de su modelo que lo alcanzan y que todavía
+// no estaban vinculadas. Antes desvincula las campañas pendientes que, tras un cambio de modelo
+// o de año, ya no alcanzan al auto; las reparaciones registradas se conservan como historial.
+func matchCarRecalls(ctx context.Context, recallRepo repositories.RecallRepository, carRecallRepo repositories.CarRecallRepository, car *entities.Car) error {
+	existing, err := carRecallRepo.ListByCarID(ctx, car.ID)
+	if err != nil {
+		return err
+	}
+	linked := make(map[uuid.UUID]bool, len(existing))
+	for _, carRecall := range existing {
+		if carRecall.IsOpen() && !recall.Affects(&carRecall.Recall, car) {
+			if err := carRecallRepo.Delete(ctx, carRecall.ID); err != nil {
+				return err
+			}
+			decisions.Record(ctx, fmt.Sprintf("El vehículo dejó de estar alcanzado por la campaña pendiente %s", carRecall.Recall.Campaign))
+			continue
+		}
+		linked[carRecall.RecallID] = true
+	}
+
+	recalls, err := recallRepo.ListByModelID(ctx, car.ModelID)
+	if err != nil || len(recalls) == 0 {
+		return err
+	}
+
+	var matched []*entities.CarRecall
+	for _, record := range recalls {
+		if !linked[record.ID] && recall.Affects(record, car) {
+			matched = append(matched, entities.NewCarRecall(record.ID, car.ID))
+			decisions.Record(ctx, fmt.Sprintf("El vehículo está alcanzado por la campaña %s", record.Campaign))
+		}
+	}
+	return carRecallRepo.CreateBatch(ctx, matched)
+}
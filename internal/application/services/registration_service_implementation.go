@@ -0,0 +1,113 @@
+// internal/application/services/registration_service_implementation.go
+
+package services
+
+import (
+	"car-service/internal/domain/decisions"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/errors"
+	"car-service/internal/domain/plate"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RegistrationServiceImpl struct {
+	registrationRepo repositories.RegistrationRepository
+	carRepo          repositories.CarRepository
+}
+
+func NewRegistrationService(
+	registrationRepo repositories.RegistrationRepository,
+	carRepo repositories.CarRepository,
+) services.RegistrationService {
+	return &RegistrationServiceImpl{
+		registrationRepo: registrationRepo,
+		carRepo:          carRepo,
+	}
+}
+
+// RegisterPlate asigna una patente al auto. La patente debe respetar el formato del país y no
+// estar activa en otro vehículo; la patente activa anterior del auto se da de baja en la fecha
+// de emisión de la nueva, que no puede ser anterior a la emisión de la anterior.
+func (s *RegistrationServiceImpl) RegisterPlate(ctx context.Context, registration *entities.Registration) (*entities.Registration, error) {
+	car, err := s.carRepo.GetByID(ctx, registration.CarID)
+	if err != nil {
+		return nil, err
+	}
+
+	if car.Status.IsFinal() {
+		return nil, errors.NewBusinessError("REGISTRATION_NOT_ALLOWED",
+			fmt.Sprintf("No se puede patentar un vehículo en estado %s", car.Status))
+	}
+
+	format, err := plate.Validate(registration.Country, registration.Plate)
+	if err != nil {
+		return nil, errors.NewBusinessError("INVALID_PLATE_FORMAT", err.Error())
+	}
+	registration.Country = plate.NormalizeCountry(registration.Country)
+	registration.Plate = plate.Normalize(registration.Plate)
+	registration.Format = format
+
+	existing, err := s.registrationRepo.GetActiveByPlate(ctx, registration.Country, registration.Plate)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.NewBusinessError("PLATE_ALREADY_ACTIVE",
+			fmt.Sprintf("La patente %s ya está activa en otro vehículo", registration.Plate))
+	}
+
+	previous, err := s.registrationRepo.GetActiveByCarID(ctx, car.ID)
+	if err != nil {
+		return nil, err
+	}
+	if previous != nil {
+		if registration.IssuedAt.Before(previous.IssuedAt) {
+			return nil, errors.NewBusinessError("CANCELLATION_BEFORE_ISSUE",
+				fmt.Sprintf("La fecha de emisión no puede ser anterior a la de la patente activa %s, que se da de baja en esa fecha", previous.Plate))
+		}
+		previous.Cancel(registration.IssuedAt)
+		if err := s.registrationRepo.Update(ctx, previous); err != nil {
+			return nil, err
+		}
+		decisions.Record(ctx, fmt.Sprintf("Se dio de baja la patente anterior %s del vehículo", previous.Plate))
+	}
+
+	if err := s.registrationRepo.Create(ctx, registration); err != nil {
+		return nil, err
+	}
+	return registration, nil
+}
+
+func (s *RegistrationServiceImpl) CancelRegistration(ctx context.Context, carID, id uuid.UUID, date time.Time) (*entities.Registration, error) {
+	registration, err := s.registrationRepo.GetByID(ctx, carID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !registration.IsActive() {
+		return nil, errors.NewBusinessError("REGISTRATION_ALREADY_CANCELLED", "La patente ya fue dada de baja")
+	}
+
+	if date.Before(registration.IssuedAt) {
+		return nil, errors.NewBusinessError("CANCELLATION_BEFORE_ISSUE", "La fecha de baja no puede ser anterior a la fecha de emisión")
+	}
+
+	registration.Cancel(date)
+	if err := s.registrationRepo.Update(ctx, registration); err != nil {
+		return nil, err
+	}
+	return registration, nil
+}
+
+func (s *RegistrationServiceImpl) GetCarRegistrations(ctx context.Context, carID uuid.UUID) ([]*entities.Registration, error) {
+	if _, err := s.carRepo.GetByID(ctx, carID); err != nil {
+		return nil, err
+	}
+	return s.registrationRepo.ListByCarID(ctx, carID)
+}
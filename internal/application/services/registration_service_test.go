@@ -0,0 +1,86 @@
+package services_test
+
+import (
+	"car-service/internal/application/services"
+	"car-service/internal/domain/entities"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestRegisterPlateKeepsOneActivePlatePerCar(t *testing.T) {
+	issued := date(2022, time.May, 10)
+	tests := []struct {
+		name      string
+		country   string
+		plate     string
+		issuedAt  time.Time
+		otherCar  bool
+		code      string
+		cancelled bool
+	}{
+		{name: "nueva patente da de baja la anterior", country: "AR", plate: "AC456DE", issuedAt: date(2024, time.January, 5), cancelled: true},
+		{name: "emisión el mismo día que la anterior", country: "AR", plate: "AC456DE", issuedAt: issued, cancelled: true},
+		{name: "emisión anterior a la patente activa", country: "AR", plate: "AC456DE", issuedAt: date(2021, time.January, 5), code: "CANCELLATION_BEFORE_ISSUE"},
+		{name: "patente activa en otro vehículo", country: "ar", plate: "ab-123-cd", issuedAt: date(2024, time.January, 5), otherCar: true, code: "PLATE_ALREADY_ACTIVE"},
+		{name: "formato inválido para el país", country: "BR", plate: "AB123CD", issuedAt: date(2024, time.January, 5), code: "INVALID_PLATE_FORMAT"},
+		{name: "país sin validador", country: "UY", plate: "SBA1234", issuedAt: date(2024, time.January, 5), code: "INVALID_PLATE_FORMAT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			car := entities.NewCar(uuid.New(), 2020, "Rojo", "JTDBR32E4L0123456", uuid.New())
+			other := entities.NewCar(uuid.New(), 2019, "Azul", "JTDBR32E4K0654321", uuid.New())
+			holder := car
+			if tt.otherCar {
+				holder = other
+			}
+			previous := entities.NewRegistration(holder.ID, "AB123CD", "AR", "", "mercosur", issued, nil)
+			registrations := &fakeRegistrationRepo{registrations: []*entities.Registration{previous}}
+			service := services.NewRegistrationService(registrations, newFakeCarRepo(car, other))
+
+			registration := entities.NewRegistration(car.ID, tt.plate, tt.country, "", "", tt.issuedAt, nil)
+			_, err := service.RegisterPlate(context.Background(), registration)
+			if code := businessCode(err); code != tt.code || (tt.code == "" && err != nil) {
+				t.Fatalf("código = %q (%v), se esperaba %q", code, err, tt.code)
+			}
+
+			if previous.IsActive() == tt.cancelled {
+				t.Errorf("patente anterior activa = %v, se esperaba %v", previous.IsActive(), !tt.cancelled)
+			}
+			if tt.cancelled && !previous.CancelledAt.Equal(tt.issuedAt) {
+				t.Errorf("baja = %v, se esperaba %v", previous.CancelledAt, tt.issuedAt)
+			}
+			if persisted := len(registrations.registrations) == 2; persisted != (tt.code == "") {
+				t.Errorf("patente persistida = %v", persisted)
+			}
+
+			active := 0
+			for _, r := range registrations.registrations {
+				if r.CarID == car.ID && r.IsActive() {
+					active++
+				}
+			}
+			expected := 1
+			if tt.otherCar {
+				expected = 0
+			}
+			if active != expected {
+				t.Errorf("patentes activas del vehículo = %d, se esperaba %d", active, expected)
+			}
+		})
+	}
+}
+
+func TestRegisterPlateKeepsExpiredPlateActive(t *testing.T) {
+	car := entities.NewCar(uuid.New(), 2020, "Rojo", "JTDBR32E4L0123456", uuid.New())
+	expired := date(2023, time.January, 1)
+	previous := entities.NewRegistration(uuid.New(), "AB123CD", "AR", "", "mercosur", date(2020, time.January, 1), &expired)
+	service := services.NewRegistrationService(&fakeRegistrationRepo{registrations: []*entities.Registration{previous}}, newFakeCarRepo(car))
+
+	_, err := service.RegisterPlate(context.Background(), entities.NewRegistration(car.ID, "AB123CD", "AR", "", "", date(2024, time.January, 5), nil))
+	if code := businessCode(err); code != "PLATE_ALREADY_ACTIVE" {
+		t.Fatalf("código = %q (%v), se esperaba PLATE_ALREADY_ACTIVE: una patente vencida sigue reservada hasta su baja", code, err)
+	}
+}
@@ -0,0 +1,151 @@
+// internal/application/services/service_record_service_implementation.go
+
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/errors"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ServiceRecordServiceImpl struct {
+	serviceRepo     repositories.ServiceRecordRepository
+	carRepo         repositories.CarRepository
+	odometerService services.OdometerService
+	warrantyService services.WarrantyService
+}
+
+func NewServiceRecordService(
+	serviceRepo repositories.ServiceRecordRepository,
+	carRepo repositories.CarRepository,
+	odometerService services.OdometerService,
+	warrantyService services.WarrantyService,
+) services.ServiceRecordService {
+	return &ServiceRecordServiceImpl{
+		serviceRepo:     serviceRepo,
+		carRepo:         carRepo,
+		odometerService: odometerService,
+		warrantyService: warrantyService,
+	}
+}
+
+func (s *ServiceRecordServiceImpl) CreateServiceRecord(ctx context.Context, record *entities.ServiceRecord) (*entities.ServiceRecord, error) {
+	if _, err := s.carRepo.GetByID(ctx, record.CarID); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateOdometer(ctx, record); err != nil {
+		return nil, err
+	}
+
+	if err := s.applyWarranty(ctx, record); err != nil {
+		return nil, err
+	}
+
+	if err := s.serviceRepo.Create(ctx, record); err != nil {
+		return nil, err
+	}
+
+	if err := s.odometerService.RecordSourceReading(ctx, serviceOdometerReading(record)); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (s *ServiceRecordServiceImpl) GetServiceRecords(ctx context.Context, carID uuid.UUID) ([]*entities.ServiceRecord, error) {
+	if _, err := s.carRepo.GetByID(ctx, carID); err != nil {
+		return nil, err
+	}
+	return s.serviceRepo.ListByCarID(ctx, carID)
+}
+
+func (s *ServiceRecordServiceImpl) GetServiceRecord(ctx context.Context, carID, id uuid.UUID) (*entities.ServiceRecord, error) {
+	return s.serviceRepo.GetByID(ctx, carID, id)
+}
+
+func (s *ServiceRecordServiceImpl) UpdateServiceRecord(ctx context.Context, record *entities.ServiceRecord) (*entities.ServiceRecord, error) {
+	existingRecord, err := s.serviceRepo.GetByID(ctx, record.CarID, record.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateOdometer(ctx, record); err != nil {
+		return nil, err
+	}
+
+	if err := s.applyWarranty(ctx, record); err != nil {
+		return nil, err
+	}
+
+	record.CreatedAt = existingRecord.CreatedAt
+	record.UpdatedAt = time.Now()
+	if err := s.serviceRepo.Update(ctx, record); err != nil {
+		return nil, err
+	}
+
+	if err := s.odometerService.RecordSourceReading(ctx, serviceOdometerReading(record)); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (s *ServiceRecordServiceImpl) DeleteServiceRecord(ctx context.Context, carID, id uuid.UUID) error {
+	if _, err := s.serviceRepo.GetByID(ctx, carID, id); err != nil {
+		return err
+	}
+
+	if err := s.serviceRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	return s.odometerService.RemoveSourceReading(ctx, carID, entities.OdometerSourceService, id)
+}
+
+// validateOdometer verifica que el kilometraje no sea menor al de un servicio anterior
+// ni mayor al de un servicio posterior del mismo auto
+func (s *ServiceRecordServiceImpl) validateOdometer(ctx context.Context, record *entities.ServiceRecord) error {
+	records, err := s.serviceRepo.ListByCarID(ctx, record.CarID)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range records {
+		if other.ID == record.ID {
+			continue
+		}
+
+		if !other.Date.After(record.Date) && other.Odometer > record.Odometer {
+			return errors.NewBusinessError("ODOMETER_DECREASED",
+				fmt.Sprintf("El kilometraje %d es menor al registrado en el servicio del %s (%d)",
+					record.Odometer, other.Date.Format(time.DateOnly), other.Odometer))
+		}
+
+		if other.Date.After(record.Date) && other.Odometer < record.Odometer {
+			return errors.NewBusinessError("ODOMETER_EXCEEDS_LATER_SERVICE",
+				fmt.Sprintf("El kilometraje %d es mayor al registrado en el servicio posterior del %s (%d)",
+					record.Odometer, other.Date.Format(time.DateOnly), other.Odometer))
+		}
+	}
+	return nil
+}
+
+// applyWarranty marca si el trabajo del servicio quedó cubierto por la garantía de fábrica del auto
+func (s *ServiceRecordServiceImpl) applyWarranty(ctx context.Context, record *entities.ServiceRecord) error {
+	coverage, err := s.warrantyService.CoveringService(ctx, record)
+	if err != nil {
+		return err
+	}
+	record.WarrantyCovered = coverage != nil
+	return nil
+}
+
+// serviceOdometerReading construye la lectura de kilometraje que aporta un servicio al historial del auto
+func serviceOdometerReading(record *entities.ServiceRecord) *entities.OdometerReading {
+	return entities.NewOdometerReading(record.CarID, record.Date, record.Odometer, entities.OdometerSourceService, &record.ID,
+		fmt.Sprintf("Servicio en %s", record.Workshop))
+}
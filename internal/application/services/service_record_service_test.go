@@ -0,0 +1,77 @@
+package services_test
+
+import (
+	"car-service/internal/application/services"
+	"car-service/internal/domain/entities"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestServiceRecordValidatesOdometer(t *testing.T) {
+	car := entities.NewCar(uuid.New(), 2020, "Rojo", "JTDBR32E4L0123456", uuid.New())
+	newRecord := func(on time.Time, odometer int) *entities.ServiceRecord {
+		return entities.NewServiceRecord(car.ID, on, odometer, "Taller Centro", entities.ServiceTypeMaintenance, 100, "")
+	}
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		odometer int
+		code     string
+	}{
+		{name: "mayor al servicio anterior", date: date(2022, time.June, 1), odometer: 25000},
+		{name: "igual al servicio anterior", date: date(2022, time.June, 1), odometer: 20000},
+		{name: "menor al servicio anterior", date: date(2022, time.June, 1), odometer: 19000, code: "ODOMETER_DECREASED"},
+		{name: "menor a un servicio de la misma fecha", date: date(2022, time.January, 1), odometer: 19000, code: "ODOMETER_DECREASED"},
+		{name: "entre dos servicios", date: date(2022, time.June, 1), odometer: 30000},
+		{name: "mayor a un servicio posterior", date: date(2022, time.June, 1), odometer: 45000, code: "ODOMETER_EXCEEDS_LATER_SERVICE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records := &fakeServiceRecordRepo{records: []*entities.ServiceRecord{
+				newRecord(date(2022, time.January, 1), 20000),
+				newRecord(date(2023, time.January, 1), 40000),
+			}}
+			odometer := &fakeOdometerService{}
+			service := services.NewServiceRecordService(records, newFakeCarRepo(car), odometer, &fakeWarrantyService{})
+
+			created, err := service.CreateServiceRecord(context.Background(), newRecord(tt.date, tt.odometer))
+			if code := businessCode(err); code != tt.code {
+				t.Fatalf("código = %q (%v), se esperaba %q", code, err, tt.code)
+			}
+			if tt.code == "" && err != nil {
+				t.Fatalf("error inesperado: %v", err)
+			}
+
+			if tt.code != "" {
+				if len(records.records) != 2 || len(odometer.readings) != 0 {
+					t.Errorf("un servicio rechazado no debería guardarse ni generar lecturas")
+				}
+				return
+			}
+			if len(odometer.readings) != 1 || odometer.readings[0].Value != tt.odometer || *odometer.readings[0].SourceID != created.ID {
+				t.Errorf("se esperaba una lectura de %d km asociada al servicio", tt.odometer)
+			}
+		})
+	}
+}
+
+func TestUpdateServiceRecordIgnoresItsOwnOdometer(t *testing.T) {
+	car := entities.NewCar(uuid.New(), 2020, "Rojo", "JTDBR32E4L0123456", uuid.New())
+	record := entities.NewServiceRecord(car.ID, date(2022, time.January, 1), 20000, "Taller Centro", entities.ServiceTypeMaintenance, 100, "")
+	records := &fakeServiceRecordRepo{records: []*entities.ServiceRecord{record}}
+	service := services.NewServiceRecordService(records, newFakeCarRepo(car), &fakeOdometerService{}, &fakeWarrantyService{})
+
+	// Corregir a la baja el kilometraje del único servicio no es un retroceso
+	corrected := *record
+	corrected.Odometer = 18000
+	if _, err := service.UpdateServiceRecord(context.Background(), &corrected); err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+	if records.records[0].Odometer != 18000 {
+		t.Errorf("kilometraje = %d, se esperaba 18000", records.records[0].Odometer)
+	}
+}
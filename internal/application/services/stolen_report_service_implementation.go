@@ -0,0 +1,170 @@
+// internal/application/services/stolen_report_service_implementation.go
+
+package services
+
+import (
+	"car-service/internal/domain/decisions"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/errors"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StolenReportServiceImpl struct {
+	stolenRepo repositories.StolenReportRepository
+	carRepo    repositories.CarRepository
+	statusRepo repositories.CarStatusTransitionRepository
+}
+
+func NewStolenReportService(
+	stolenRepo repositories.StolenReportRepository,
+	carRepo repositories.CarRepository,
+	statusRepo repositories.CarStatusTransitionRepository,
+) services.StolenReportService {
+	return &StolenReportServiceImpl{
+		stolenRepo: stolenRepo,
+		carRepo:    carRepo,
+		statusRepo: statusRepo,
+	}
+}
+
+// ReportStolen registra una denuncia de robo. Si el VIN corresponde a un vehículo registrado,
+// la denuncia se asocia a él y el vehículo pasa a estado stolen, salvo que esté en un estado final.
+func (s *StolenReportServiceImpl) ReportStolen(ctx context.Context, report *entities.StolenReport) (*entities.StolenReport, error) {
+	car, err := s.resolveCar(ctx, report)
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := s.stolenRepo.GetActiveByVIN(ctx, report.VIN)
+	if err != nil {
+		return nil, err
+	}
+	if active != nil {
+		return nil, errors.NewBusinessError("STOLEN_REPORT_ALREADY_ACTIVE",
+			fmt.Sprintf("El VIN ya tiene una denuncia de robo vigente (%s)", active.PoliceReference))
+	}
+
+	if err := s.stolenRepo.Create(ctx, report); err != nil {
+		return nil, err
+	}
+
+	// Un vehículo desguazado o exportado conserva su estado; la denuncia igual integra la lista de vigilancia
+	if car != nil && car.Status.IsFinal() {
+		decisions.Record(ctx, fmt.Sprintf("El vehículo está en estado final %s; la denuncia se registra sin cambiar su estado", car.Status))
+		return report, nil
+	}
+
+	if car != nil && car.Status != entities.CarStatusStolen {
+		if err := s.changeStatus(ctx, car, entities.CarStatusStolen, fmt.Sprintf("Denuncia de robo %s", report.PoliceReference)); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// RecoverStolen registra el recupero del vehículo y lo quita de la lista de vigilancia
+func (s *StolenReportServiceImpl) RecoverStolen(ctx context.Context, id uuid.UUID, date time.Time) (*entities.StolenReport, error) {
+	report, err := s.stolenRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !report.IsActive() {
+		return nil, errors.NewBusinessError("STOLEN_REPORT_ALREADY_RECOVERED", "La denuncia ya tiene registrado el recupero del vehículo")
+	}
+
+	if date.Before(report.ReportDate) {
+		return nil, errors.NewBusinessError("RECOVERY_BEFORE_REPORT", "La fecha de recupero no puede ser anterior a la fecha de la denuncia")
+	}
+
+	report.Recover(date)
+	if err := s.stolenRepo.Update(ctx, report); err != nil {
+		return nil, err
+	}
+
+	if report.CarID != nil {
+		car, err := s.carRepo.GetByID(ctx, *report.CarID)
+		if err != nil {
+			return nil, err
+		}
+		if car.Status == entities.CarStatusStolen {
+			if err := s.changeStatus(ctx, car, entities.CarStatusRegistered, fmt.Sprintf("Recupero de la denuncia %s", report.PoliceReference)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return report, nil
+}
+
+func (s *StolenReportServiceImpl) GetStolenReport(ctx context.Context, id uuid.UUID) (*entities.StolenReport, error) {
+	return s.stolenRepo.GetByID(ctx, id)
+}
+
+func (s *StolenReportServiceImpl) GetCarStolenReports(ctx context.Context, carID uuid.UUID) ([]*entities.StolenReport, error) {
+	if _, err := s.carRepo.GetByID(ctx, carID); err != nil {
+		return nil, err
+	}
+	return s.stolenRepo.ListByCarID(ctx, carID)
+}
+
+func (s *StolenReportServiceImpl) CheckVIN(ctx context.Context, vin string) (*entities.StolenReport, error) {
+	return s.stolenRepo.GetActiveByVIN(ctx, vin)
+}
+
+// resolveCar asocia la denuncia con el vehículo indicado o con el registrado bajo el mismo VIN
+func (s *StolenReportServiceImpl) resolveCar(ctx context.Context, report *entities.StolenReport) (*entities.Car, error) {
+	if report.CarID != nil {
+		car, err := s.carRepo.GetByID(ctx, *report.CarID)
+		if err != nil {
+			return nil, err
+		}
+		if report.VIN == "" {
+			report.VIN = car.VIN
+		} else if report.VIN != car.VIN {
+			return nil, errors.NewBusinessError("STOLEN_REPORT_VIN_MISMATCH", "El VIN informado no coincide con el VIN del vehículo")
+		}
+		return car, nil
+	}
+
+	car, err := s.carRepo.GetByVIN(ctx, report.VIN)
+	if err != nil || car == nil {
+		decisions.Record(ctx, fmt.Sprintf("El VIN %s no corresponde a un vehículo registrado; la denuncia queda solo en la lista de vigilancia", report.VIN))
+		return nil, nil
+	}
+	report.CarID = &car.ID
+	return car, nil
+}
+
+func (s *StolenReportServiceImpl) changeStatus(ctx context.Context, car *entities.Car, status entities.CarStatus, reason string) error {
+	transition, err := car.ChangeStatus(status, reason)
+	if err != nil {
+		return err
+	}
+	if err := s.carRepo.Update(ctx, car); err != nil {
+		return err
+	}
+	if err := s.statusRepo.Create(ctx, transition); err != nil {
+		return err
+	}
+	decisions.Record(ctx, fmt.Sprintf("El vehículo pasó del estado %s al estado %s", transition.FromStatus, transition.ToStatus))
+	return nil
+}
+
+// checkWatchlist rechaza la operación si el VIN tiene una denuncia de robo vigente
+func checkWatchlist(ctx context.Context, stolenRepo repositories.StolenReportRepository, vin string) error {
+	report, err := stolenRepo.GetActiveByVIN(ctx, vin)
+	if err != nil {
+		return err
+	}
+	if report != nil {
+		return errors.NewBusinessError("VIN_REPORTED_STOLEN",
+			fmt.Sprintf("El VIN %s tiene una denuncia de robo vigente (%s)", vin, report.PoliceReference))
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package services_test
+
+import (
+	"car-service/internal/application/services"
+	"car-service/internal/domain/entities"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestReportStolenChangesCarStatus(t *testing.T) {
+	tests := []struct {
+		status      entities.CarStatus
+		after       entities.CarStatus
+		transitions int
+	}{
+		{status: entities.CarStatusRegistered, after: entities.CarStatusStolen, transitions: 1},
+		{status: entities.CarStatusForSale, after: entities.CarStatusStolen, transitions: 1},
+		{status: entities.CarStatusScrapped, after: entities.CarStatusScrapped},
+		{status: entities.CarStatusExported, after: entities.CarStatusExported},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			car := entities.NewCar(uuid.New(), 2020, "Rojo", "JTDBR32E4L0123456", uuid.New())
+			car.Status = tt.status
+			stolen, status := &fakeStolenRepo{}, &fakeStatusRepo{}
+			service := services.NewStolenReportService(stolen, newFakeCarRepo(car), status)
+
+			report, err := service.ReportStolen(context.Background(), entities.NewStolenReport(car.VIN, nil, date(2024, time.March, 1), "DEN-1", ""))
+			if err != nil {
+				t.Fatalf("error inesperado: %v", err)
+			}
+
+			if report.CarID == nil || *report.CarID != car.ID {
+				t.Errorf("la denuncia no quedó asociada al vehículo del VIN")
+			}
+			if car.Status != tt.after {
+				t.Errorf("estado = %s, se esperaba %s", car.Status, tt.after)
+			}
+			if len(status.transitions) != tt.transitions {
+				t.Errorf("transiciones = %d, se esperaban %d", len(status.transitions), tt.transitions)
+			}
+		})
+	}
+}
+
+func TestReportStolenRejectsSecondActiveReport(t *testing.T) {
+	stolen := &fakeStolenRepo{reports: []*entities.StolenReport{
+		entities.NewStolenReport("JTDBR32E4L0123456", nil, date(2024, time.March, 1), "DEN-1", ""),
+	}}
+	service := services.NewStolenReportService(stolen, newFakeCarRepo(), &fakeStatusRepo{})
+
+	_, err := service.ReportStolen(context.Background(), entities.NewStolenReport("JTDBR32E4L0123456", nil, date(2024, time.April, 1), "DEN-2", ""))
+	if code := businessCode(err); code != "STOLEN_REPORT_ALREADY_ACTIVE" {
+		t.Fatalf("código = %q (%v), se esperaba STOLEN_REPORT_ALREADY_ACTIVE", code, err)
+	}
+	if len(stolen.reports) != 1 {
+		t.Errorf("denuncias = %d, se esperaba 1", len(stolen.reports))
+	}
+}
+
+func TestRecoverStolenReturnsCarToRegistered(t *testing.T) {
+	reportDate := date(2024, time.March, 1)
+	tests := []struct {
+		name  string
+		date  time.Time
+		code  string
+		after entities.CarStatus
+	}{
+		{name: "recupero posterior a la denuncia", date: date(2024, time.April, 1), after: entities.CarStatusRegistered},
+		{name: "recupero anterior a la denuncia", date: date(2024, time.February, 1), code: "RECOVERY_BEFORE_REPORT", after: entities.CarStatusStolen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			car := entities.NewCar(uuid.New(), 2020, "Rojo", "JTDBR32E4L0123456", uuid.New())
+			car.Status = entities.CarStatusStolen
+			report := entities.NewStolenReport(car.VIN, &car.ID, reportDate, "DEN-1", "")
+			stolen := &fakeStolenRepo{reports: []*entities.StolenReport{report}}
+			service := services.NewStolenReportService(stolen, newFakeCarRepo(car), &fakeStatusRepo{})
+
+			_, err := service.RecoverStolen(context.Background(), report.ID, tt.date)
+			if code := businessCode(err); code != tt.code || (tt.code == "" && err != nil) {
+				t.Fatalf("código = %q (%v), se esperaba %q", code, err, tt.code)
+			}
+
+			if car.Status != tt.after {
+				t.Errorf("estado = %s, se esperaba %s", car.Status, tt.after)
+			}
+			if report.IsActive() != (tt.code != "") {
+				t.Errorf("denuncia vigente = %v", report.IsActive())
+			}
+		})
+	}
+}
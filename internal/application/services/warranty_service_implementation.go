@@ -0,0 +1,177 @@
+// internal/application/services/warranty_service_implementation.go
+
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/errors"
+	"car-service/internal/domain/odometer"
+	"car-service/internal/domain/repositories"
+	"car-service/internal/domain/services"
+	"car-service/internal/domain/warranty"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WarrantyServiceImpl struct {
+	warrantyRepo     repositories.WarrantyTemplateRepository
+	carRepo          repositories.CarRepository
+	modelRepo        repositories.ModelRepository
+	brandRepo        repositories.BrandRepository
+	registrationRepo repositories.RegistrationRepository
+	odometerService  services.OdometerService
+}
+
+func NewWarrantyService(
+	warrantyRepo repositories.WarrantyTemplateRepository,
+	carRepo repositories.CarRepository,
+	modelRepo repositories.ModelRepository,
+	brandRepo repositories.BrandRepository,
+	registrationRepo repositories.RegistrationRepository,
+	odometerService services.OdometerService,
+) services.WarrantyService {
+	return &WarrantyServiceImpl{
+		warrantyRepo:     warrantyRepo,
+		carRepo:          carRepo,
+		modelRepo:        modelRepo,
+		brandRepo:        brandRepo,
+		registrationRepo: registrationRepo,
+		odometerService:  odometerService,
+	}
+}
+
+// CreateTemplate registra la plantilla de una marca o de uno de sus modelos. Cada marca y cada
+// modelo admiten una sola plantilla.
+func (s *WarrantyServiceImpl) CreateTemplate(ctx context.Context, template *entities.WarrantyTemplate) (*entities.WarrantyTemplate, error) {
+	if _, err := s.brandRepo.GetByID(ctx, template.BrandID); err != nil {
+		return nil, errors.NewBusinessError("BRAND_NOT_FOUND", "La marca especificada no existe")
+	}
+
+	var existing *entities.WarrantyTemplate
+	if template.ModelID != nil {
+		model, err := s.modelRepo.GetByID(ctx, *template.ModelID)
+		if err != nil {
+			return nil, errors.NewBusinessError("MODEL_NOT_FOUND", "El modelo especificado no existe")
+		}
+		if model.BrandID != template.BrandID {
+			return nil, errors.NewBusinessError("MODEL_BRAND_MISMATCH", "El modelo no pertenece a la marca especificada")
+		}
+
+		if existing, err = s.warrantyRepo.GetByModelID(ctx, model.ID); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if existing, err = s.warrantyRepo.GetByBrandID(ctx, template.BrandID); err != nil {
+			return nil, err
+		}
+	}
+	if existing != nil {
+		return nil, errors.NewBusinessError("WARRANTY_TEMPLATE_EXISTS",
+			fmt.Sprintf("Ya existe la plantilla de garantía %s para esta marca o modelo", existing.Name))
+	}
+
+	if err := s.warrantyRepo.Create(ctx, template); err != nil {
+		return nil, err
+	}
+	return s.warrantyRepo.GetByID(ctx, template.ID)
+}
+
+func (s *WarrantyServiceImpl) GetTemplate(ctx context.Context, id uuid.UUID) (*entities.WarrantyTemplate, error) {
+	return s.warrantyRepo.GetByID(ctx, id)
+}
+
+func (s *WarrantyServiceImpl) GetTemplates(ctx context.Context) ([]*entities.WarrantyTemplate, error) {
+	return s.warrantyRepo.List(ctx)
+}
+
+func (s *WarrantyServiceImpl) DeleteTemplate(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.warrantyRepo.GetByID(ctx, id); err != nil {
+		return err
+	}
+	return s.warrantyRepo.Delete(ctx, id)
+}
+
+// GetCarWarranty calcula la cobertura restante del auto en la fecha indicada usando su última
+// lectura de kilometraje consistente
+func (s *WarrantyServiceImpl) GetCarWarranty(ctx context.Context, carID uuid.UUID, at time.Time) (*services.CarWarranty, error) {
+	car, err := s.carRepo.GetByIDWithRelations(ctx, carID, repositories.CarRelations{Model: true})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &services.CarWarranty{Car: car}
+	if result.Template, err = s.carTemplate(ctx, car); err != nil {
+		return nil, err
+	}
+	if result.StartDate, result.FirstRegistered, err = s.warrantyStart(ctx, car); err != nil {
+		return nil, err
+	}
+
+	history, err := s.odometerService.GetOdometerHistory(ctx, carID)
+	if err != nil {
+		return nil, err
+	}
+	result.Odometer = odometer.Latest(history.Readings)
+
+	if result.Template != nil {
+		distance := 0
+		if result.Odometer != nil {
+			distance = result.Odometer.Value
+		}
+		result.Coverages = warranty.EvaluateAll(result.Template, result.StartDate, distance, at)
+	}
+	return result, nil
+}
+
+// CoveringService evalúa la garantía del auto en la fecha y con el kilometraje del servicio
+func (s *WarrantyServiceImpl) CoveringService(ctx context.Context, record *entities.ServiceRecord) (*entities.WarrantyCoverage, error) {
+	car, err := s.carRepo.GetByIDWithRelations(ctx, record.CarID, repositories.CarRelations{Model: true})
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := s.carTemplate(ctx, car)
+	if err != nil || template == nil {
+		return nil, err
+	}
+
+	start, _, err := s.warrantyStart(ctx, car)
+	if err != nil {
+		return nil, err
+	}
+	return warranty.CoveringService(template, start, record.Type, record.ComponentGroup, record.Date, record.Odometer), nil
+}
+
+// carTemplate retorna la plantilla del modelo del auto o, si no tiene, la de su marca.
+// El auto debe tener el modelo cargado.
+func (s *WarrantyServiceImpl) carTemplate(ctx context.Context, car *entities.Car) (*entities.WarrantyTemplate, error) {
+	template, err := s.warrantyRepo.GetByModelID(ctx, car.ModelID)
+	if err != nil || template != nil {
+		return template, err
+	}
+	return s.warrantyRepo.GetByBrandID(ctx, car.Model.BrandID)
+}
+
+// warrantyStart retorna la fecha del primer patentamiento del auto, incluidas las patentes dadas
+// de baja. Si nunca fue patentado la garantía se cuenta desde su alta en el sistema.
+func (s *WarrantyServiceImpl) warrantyStart(ctx context.Context, car *entities.Car) (time.Time, bool, error) {
+	registrations, err := s.registrationRepo.ListByCarID(ctx, car.ID)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if len(registrations) == 0 {
+		return car.CreatedAt, false, nil
+	}
+
+	start := registrations[0].IssuedAt
+	for _, registration := range registrations[1:] {
+		if registration.IssuedAt.Before(start) {
+			start = registration.IssuedAt
+		}
+	}
+	return start, true, nil
+}
@@ -0,0 +1,128 @@
+package services_test
+
+import (
+	"car-service/internal/application/services"
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/warranty"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestGetCarWarrantyResolvesTemplate(t *testing.T) {
+	brandID := uuid.New()
+	model := entities.NewModel("Corolla", brandID, 2015, "Sedan")
+	otherModelID := uuid.New()
+
+	brandTemplate := entities.NewWarrantyTemplate(brandID, nil, "Marca", []entities.WarrantyCoverage{
+		entities.NewWarrantyCoverage(entities.ComponentGroupGeneral, 36, 100000),
+	})
+	modelTemplate := entities.NewWarrantyTemplate(brandID, &model.ID, "Modelo", []entities.WarrantyCoverage{
+		entities.NewWarrantyCoverage(entities.ComponentGroupGeneral, 60, 150000),
+	})
+	otherModelTemplate := entities.NewWarrantyTemplate(brandID, &otherModelID, "Otro modelo", nil)
+	otherBrandTemplate := entities.NewWarrantyTemplate(uuid.New(), nil, "Otra marca", nil)
+
+	tests := []struct {
+		name      string
+		templates []*entities.WarrantyTemplate
+		expected  *entities.WarrantyTemplate
+	}{
+		{name: "plantilla del modelo sobre la de la marca", templates: []*entities.WarrantyTemplate{brandTemplate, modelTemplate}, expected: modelTemplate},
+		{name: "solo plantilla del modelo", templates: []*entities.WarrantyTemplate{modelTemplate}, expected: modelTemplate},
+		{name: "plantilla de la marca si el modelo no tiene", templates: []*entities.WarrantyTemplate{brandTemplate, otherModelTemplate}, expected: brandTemplate},
+		{name: "sin plantillas aplicables", templates: []*entities.WarrantyTemplate{otherModelTemplate, otherBrandTemplate}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			car := entities.NewCar(model.ID, 2022, "Rojo", "JTDBR32E4N0123456", uuid.New())
+			car.Model = *model
+			service := services.NewWarrantyService(&fakeWarrantyTemplateRepo{templates: tt.templates}, newFakeCarRepo(car),
+				newFakeModelRepo(model), nil, &fakeRegistrationRepo{}, &fakeOdometerService{})
+
+			result, err := service.GetCarWarranty(context.Background(), car.ID, date(2024, time.January, 1))
+			if err != nil {
+				t.Fatalf("error inesperado: %v", err)
+			}
+
+			if result.Template != tt.expected {
+				t.Fatalf("plantilla = %v, se esperaba %v", templateName(result.Template), templateName(tt.expected))
+			}
+			expected := 0
+			if tt.expected != nil {
+				expected = len(tt.expected.Coverages)
+			}
+			if len(result.Coverages) != expected {
+				t.Errorf("coberturas = %d, se esperaban %d", len(result.Coverages), expected)
+			}
+		})
+	}
+}
+
+func TestGetCarWarrantyStartsAtFirstRegistration(t *testing.T) {
+	brandID := uuid.New()
+	model := entities.NewModel("Corolla", brandID, 2015, "Sedan")
+	template := entities.NewWarrantyTemplate(brandID, nil, "Marca", []entities.WarrantyCoverage{
+		entities.NewWarrantyCoverage(entities.ComponentGroupPowertrain, 36, 100000),
+	})
+	firstIssued := date(2021, time.March, 1)
+
+	tests := []struct {
+		name          string
+		registrations func(carID uuid.UUID) []*entities.Registration
+		start         func(car *entities.Car) time.Time
+		registered    bool
+	}{
+		{
+			name: "primer patentamiento, aunque esté dado de baja",
+			registrations: func(carID uuid.UUID) []*entities.Registration {
+				first := entities.NewRegistration(carID, "AB123CD", "AR", "", "mercosur", firstIssued, nil)
+				first.Cancel(date(2022, time.May, 1))
+				return []*entities.Registration{
+					entities.NewRegistration(carID, "AC456DE", "AR", "", "mercosur", date(2022, time.May, 1), nil),
+					first,
+				}
+			},
+			start:      func(*entities.Car) time.Time { return firstIssued },
+			registered: true,
+		},
+		{
+			name:          "alta en el sistema si nunca fue patentado",
+			registrations: func(uuid.UUID) []*entities.Registration { return nil },
+			start:         func(car *entities.Car) time.Time { return car.CreatedAt },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			car := entities.NewCar(model.ID, 2021, "Rojo", "JTDBR32E4M0123456", uuid.New())
+			car.Model = *model
+			odometer := &fakeOdometerService{readings: []*entities.OdometerReading{
+				entities.NewOdometerReading(car.ID, date(2023, time.June, 1), 120000, entities.OdometerSourceManual, nil, ""),
+			}}
+			service := services.NewWarrantyService(&fakeWarrantyTemplateRepo{templates: []*entities.WarrantyTemplate{template}},
+				newFakeCarRepo(car), newFakeModelRepo(model), nil,
+				&fakeRegistrationRepo{registrations: tt.registrations(car.ID)}, odometer)
+
+			result, err := service.GetCarWarranty(context.Background(), car.ID, date(2023, time.July, 1))
+			if err != nil {
+				t.Fatalf("error inesperado: %v", err)
+			}
+
+			if !result.StartDate.Equal(tt.start(car)) || result.FirstRegistered != tt.registered {
+				t.Errorf("inicio = %v (patentado %v), se esperaba %v (%v)", result.StartDate, result.FirstRegistered, tt.start(car), tt.registered)
+			}
+			if len(result.Coverages) != 1 || result.Coverages[0].ExpiredBy != warranty.ExpiredByOdometer {
+				t.Errorf("coberturas = %+v, se esperaba una vencida por kilometraje", result.Coverages)
+			}
+		})
+	}
+}
+
+func templateName(template *entities.WarrantyTemplate) string {
+	if template == nil {
+		return "ninguna"
+	}
+	return template.Name
+}
@@ -0,0 +1,22 @@
+package decisions
+
+import "context"
+
+// Recorder acumula las decisiones de negocio tomadas durante un caso de uso
+type Recorder interface {
+	AddDecision(decision string)
+}
+
+type recorderKey struct{}
+
+// WithRecorder adjunta un Recorder al contexto
+func WithRecorder(ctx context.Context, recorder Recorder) context.Context {
+	return context.WithValue(ctx, recorderKey{}, recorder)
+}
+
+// Record registra una decisión en el Recorder del contexto; si no hay uno, la descarta
+func Record(ctx context.Context, decision string) {
+	if recorder, ok := ctx.Value(recorderKey{}).(Recorder); ok {
+		recorder.AddDecision(decision)
+	}
+}
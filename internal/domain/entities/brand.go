@@ -10,7 +10,7 @@ import (
 // Brand representa una marca de automóvil
 type Brand struct {
 	ID        uuid.UUID `gorm:"type:uuid;primary_key"`
-	Name      string    `gorm:"unique;not null"`
+	Name      string    `gorm:"not null;uniqueIndex:idx_brands_name,where:deleted_at IS NULL"`
 	Country   string    // País de origen de la marca
 	LogoURL   string    // URL del logo de la marca
 	Active    bool      `gorm:"default:true"` // Indica si la marca está activa en el sistema
@@ -9,18 +9,21 @@ import (
 
 // Car representa un vehículo específico
 type Car struct {
-	ID        uuid.UUID `gorm:"type:uuid;primary_key"`
-	ModelID   uuid.UUID `gorm:"type:uuid;not null"`
-	Model     Model     `gorm:"foreignKey:ModelID"`
-	Year      int       `gorm:"not null"` // Año de fabricación del vehículo específico
-	Color     string
-	VIN       string    `gorm:"unique"` // Vehicle Identification Number
-	OwnerID   uuid.UUID `gorm:"type:uuid"`
-	Owner     Owner     `gorm:"foreignKey:OwnerID"`
-	Active    bool      `gorm:"default:true"` // Indica si el auto está activo en el sistema
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	ID              uuid.UUID  `gorm:"type:uuid;primary_key"`
+	ModelID         uuid.UUID  `gorm:"type:uuid;not null"`
+	Model           Model      `gorm:"foreignKey:ModelID"`
+	TrimID          *uuid.UUID `gorm:"type:uuid;index"` // Versión del modelo; nula si no se informa
+	Trim            *ModelTrim `gorm:"foreignKey:TrimID"`
+	Year            int        `gorm:"not null"` // Año de fabricación del vehículo específico
+	Color           string
+	VIN             string    `gorm:"uniqueIndex:idx_cars_vin,where:deleted_at IS NULL"` // Vehicle Identification Number; único entre los vehículos no eliminados
+	OwnerID         uuid.UUID `gorm:"type:uuid"`
+	Owner           Owner     `gorm:"foreignKey:OwnerID"`
+	Status          CarStatus `gorm:"not null;default:'registered';index"` // Etapa del ciclo de vida del vehículo
+	OdometerFlagged bool      // Indica si el historial de kilometraje tiene anomalías
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
 }
 
 // BeforeCreate se ejecuta antes de crear un nuevo registro
@@ -39,7 +42,7 @@ func NewCar(modelID uuid.UUID, year int, color string, vin string, ownerID uuid.
 		Color:     color,
 		VIN:       vin,
 		OwnerID:   ownerID,
-		Active:    true,
+		Status:    CarStatusRegistered,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -0,0 +1,139 @@
+package entities
+
+import (
+	"car-service/internal/domain/errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CarStatus es la etapa del ciclo de vida en la que se encuentra un vehículo
+type CarStatus string
+
+const (
+	CarStatusRegistered CarStatus = "registered" // Registrado y en uso
+	CarStatusInService  CarStatus = "in_service" // En taller
+	CarStatusForSale    CarStatus = "for_sale"   // Publicado para la venta
+	CarStatusSold       CarStatus = "sold"       // Vendido, pendiente de transferencia
+	CarStatusStolen     CarStatus = "stolen"     // Con denuncia de robo
+	CarStatusScrapped   CarStatus = "scrapped"   // Dado de baja para desguace
+	CarStatusExported   CarStatus = "exported"   // Exportado fuera del país
+)
+
+// CarStatuses son los estados aceptados
+var CarStatuses = []CarStatus{
+	CarStatusRegistered,
+	CarStatusInService,
+	CarStatusForSale,
+	CarStatusSold,
+	CarStatusStolen,
+	CarStatusScrapped,
+	CarStatusExported,
+}
+
+// carStatusTransitions define los estados a los que se puede pasar desde cada estado.
+// Los estados sin transiciones (desguazado y exportado) son finales.
+var carStatusTransitions = map[CarStatus][]CarStatus{
+	CarStatusRegistered: {CarStatusInService, CarStatusForSale, CarStatusStolen, CarStatusScrapped, CarStatusExported},
+	CarStatusInService:  {CarStatusRegistered, CarStatusForSale, CarStatusStolen, CarStatusScrapped},
+	CarStatusForSale:    {CarStatusRegistered, CarStatusInService, CarStatusSold, CarStatusStolen},
+	CarStatusSold:       {CarStatusRegistered, CarStatusStolen, CarStatusScrapped, CarStatusExported},
+	CarStatusStolen:     {CarStatusRegistered, CarStatusScrapped},
+	CarStatusScrapped:   {},
+	CarStatusExported:   {},
+}
+
+// IsValid indica si el estado es uno de los aceptados
+func (s CarStatus) IsValid() bool {
+	_, ok := carStatusTransitions[s]
+	return ok
+}
+
+// IsFinal indica si el estado no admite transiciones
+func (s CarStatus) IsFinal() bool {
+	return s.IsValid() && len(carStatusTransitions[s]) == 0
+}
+
+// FinalCarStatuses retorna los estados finales del ciclo de vida
+func FinalCarStatuses() []CarStatus {
+	var statuses []CarStatus
+	for _, status := range CarStatuses {
+		if status.IsFinal() {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+// CanTransitionTo indica si se puede pasar del estado actual al indicado
+func (s CarStatus) CanTransitionTo(to CarStatus) bool {
+	for _, allowed := range carStatusTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedTransitions retorna los estados a los que se puede pasar desde el estado actual
+func (s CarStatus) AllowedTransitions() []CarStatus {
+	return carStatusTransitions[s]
+}
+
+// ChangeStatus aplica una transición de estado validando el ciclo de vida del vehículo
+// y retorna el registro de la transición
+func (c *Car) ChangeStatus(to CarStatus, reason string) (*CarStatusTransition, error) {
+	if !to.IsValid() {
+		return nil, errors.NewBusinessError("INVALID_CAR_STATUS", fmt.Sprintf("El estado %s no es válido", to))
+	}
+
+	if c.Status == to {
+		return nil, errors.NewBusinessError("CAR_STATUS_UNCHANGED", fmt.Sprintf("El vehículo ya se encuentra en estado %s", to))
+	}
+
+	if c.Status.IsFinal() {
+		return nil, errors.NewBusinessError("CAR_STATUS_FINAL",
+			fmt.Sprintf("El vehículo está en estado %s y no admite cambios de estado", c.Status))
+	}
+
+	if !c.Status.CanTransitionTo(to) {
+		return nil, errors.NewBusinessError("INVALID_STATUS_TRANSITION",
+			fmt.Sprintf("No se puede pasar del estado %s al estado %s", c.Status, to))
+	}
+
+	transition := NewCarStatusTransition(c.ID, c.Status, to, reason)
+	c.Status = to
+	c.UpdatedAt = time.Now()
+	return transition, nil
+}
+
+// CarStatusTransition registra un cambio de estado de un vehículo
+type CarStatusTransition struct {
+	ID         uuid.UUID `gorm:"type:uuid;primary_key"`
+	CarID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	FromStatus CarStatus // Vacío para el estado inicial del alta
+	ToStatus   CarStatus `gorm:"not null"`
+	Reason     string
+	CreatedAt  time.Time
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (t *CarStatusTransition) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+func NewCarStatusTransition(carID uuid.UUID, from, to CarStatus, reason string) *CarStatusTransition {
+	return &CarStatusTransition{
+		ID:         uuid.New(),
+		CarID:      carID,
+		FromStatus: from,
+		ToStatus:   to,
+		Reason:     reason,
+		CreatedAt:  time.Now(),
+	}
+}
@@ -0,0 +1,112 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// InspectionResult es el resultado de una inspección técnica (VTV/ITV)
+type InspectionResult string
+
+const (
+	InspectionResultApproved    InspectionResult = "approved"    // Apto
+	InspectionResultConditional InspectionResult = "conditional" // Condicional: requiere reinspección de los defectos
+	InspectionResultRejected    InspectionResult = "rejected"    // Rechazado: no puede circular hasta aprobar
+)
+
+// IsValid indica si el resultado es uno de los aceptados
+func (r InspectionResult) IsValid() bool {
+	switch r {
+	case InspectionResultApproved, InspectionResultConditional, InspectionResultRejected:
+		return true
+	}
+	return false
+}
+
+// DefectSeverity clasifica la gravedad de un defecto detectado en la inspección
+type DefectSeverity string
+
+const (
+	DefectSeverityMinor     DefectSeverity = "minor"
+	DefectSeverityMajor     DefectSeverity = "major"
+	DefectSeverityDangerous DefectSeverity = "dangerous"
+)
+
+// IsValid indica si la gravedad es una de las aceptadas
+func (s DefectSeverity) IsValid() bool {
+	switch s {
+	case DefectSeverityMinor, DefectSeverityMajor, DefectSeverityDangerous:
+		return true
+	}
+	return false
+}
+
+// Inspection representa una inspección técnica periódica de un vehículo
+type Inspection struct {
+	ID          uuid.UUID          `gorm:"type:uuid;primary_key"`
+	CarID       uuid.UUID          `gorm:"type:uuid;not null;index"`
+	Car         Car                `gorm:"foreignKey:CarID"`
+	Date        time.Time          `gorm:"not null"`
+	Station     string             `gorm:"not null"` // Planta verificadora
+	Result      InspectionResult   `gorm:"not null"`
+	Defects     []InspectionDefect `gorm:"foreignKey:InspectionID;constraint:OnDelete:CASCADE"`
+	Odometer    *int               // Kilometraje informado por la planta, si se registró
+	NextDueDate time.Time          `gorm:"not null;index"`
+	Notes       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+}
+
+// InspectionDefect representa un defecto observado durante una inspección
+type InspectionDefect struct {
+	ID           uuid.UUID      `gorm:"type:uuid;primary_key"`
+	InspectionID uuid.UUID      `gorm:"type:uuid;not null;index"`
+	Description  string         `gorm:"not null"`
+	Severity     DefectSeverity `gorm:"not null"`
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (i *Inspection) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (d *InspectionDefect) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+func NewInspection(carID uuid.UUID, date time.Time, station string, result InspectionResult, defects []InspectionDefect, odometer *int, notes string) *Inspection {
+	inspection := &Inspection{
+		ID:        uuid.New(),
+		CarID:     carID,
+		Date:      date,
+		Station:   station,
+		Result:    result,
+		Odometer:  odometer,
+		Notes:     notes,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	for i := range defects {
+		defects[i].InspectionID = inspection.ID
+	}
+	inspection.Defects = defects
+	return inspection
+}
+
+func NewInspectionDefect(description string, severity DefectSeverity) InspectionDefect {
+	return InspectionDefect{
+		ID:          uuid.New(),
+		Description: description,
+		Severity:    severity,
+	}
+}
@@ -0,0 +1,96 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CoverageType es el tipo de cobertura de una póliza de seguro
+type CoverageType string
+
+const (
+	CoverageTypeLiability     CoverageType = "liability"     // Responsabilidad civil
+	CoverageTypeThirdParty    CoverageType = "third_party"   // Terceros completo: robo, incendio y daño total
+	CoverageTypeComprehensive CoverageType = "comprehensive" // Todo riesgo
+)
+
+// IsValid indica si el tipo de cobertura es uno de los aceptados
+func (c CoverageType) IsValid() bool {
+	switch c {
+	case CoverageTypeLiability, CoverageTypeThirdParty, CoverageTypeComprehensive:
+		return true
+	}
+	return false
+}
+
+// InsurancePolicy representa una póliza de seguro de un vehículo contratada por su propietario.
+// La póliza cubre el período [StartDate, EndDate) salvo que se dé de baja antes.
+type InsurancePolicy struct {
+	ID           uuid.UUID    `gorm:"type:uuid;primary_key"`
+	CarID        uuid.UUID    `gorm:"type:uuid;not null;index"`
+	Car          Car          `gorm:"foreignKey:CarID"`
+	OwnerID      uuid.UUID    `gorm:"type:uuid;not null;index"`
+	Owner        Owner        `gorm:"foreignKey:OwnerID"`
+	Insurer      string       `gorm:"not null;uniqueIndex:idx_insurance_policies_number,where:deleted_at IS NULL"`
+	PolicyNumber string       `gorm:"not null;uniqueIndex:idx_insurance_policies_number"`
+	CoverageType CoverageType `gorm:"not null"`
+	StartDate    time.Time    `gorm:"not null"`
+	EndDate      time.Time    `gorm:"not null"`
+	Premium      float64      `gorm:"not null"`
+	TerminatedAt *time.Time   // Fecha de baja anticipada, si la póliza se dio de baja antes de su vencimiento
+	Notes        string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (p *InsurancePolicy) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsTerminated indica si la póliza se dio de baja antes de su vencimiento
+func (p *InsurancePolicy) IsTerminated() bool {
+	return p.TerminatedAt != nil
+}
+
+// EffectiveEnd retorna la fecha en que la cobertura termina: la baja anticipada o el vencimiento
+func (p *InsurancePolicy) EffectiveEnd() time.Time {
+	if p.TerminatedAt != nil && p.TerminatedAt.Before(p.EndDate) {
+		return *p.TerminatedAt
+	}
+	return p.EndDate
+}
+
+// IsActiveAt indica si la póliza brinda cobertura en la fecha indicada
+func (p *InsurancePolicy) IsActiveAt(at time.Time) bool {
+	return !at.Before(p.StartDate) && at.Before(p.EffectiveEnd())
+}
+
+// Terminate da de baja la póliza en la fecha indicada
+func (p *InsurancePolicy) Terminate(date time.Time) {
+	p.TerminatedAt = &date
+	p.UpdatedAt = time.Now()
+}
+
+func NewInsurancePolicy(carID, ownerID uuid.UUID, insurer, policyNumber string, coverageType CoverageType, startDate, endDate time.Time, premium float64, notes string) *InsurancePolicy {
+	return &InsurancePolicy{
+		ID:           uuid.New(),
+		CarID:        carID,
+		OwnerID:      ownerID,
+		Insurer:      insurer,
+		PolicyNumber: policyNumber,
+		CoverageType: coverageType,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		Premium:      premium,
+		Notes:        notes,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
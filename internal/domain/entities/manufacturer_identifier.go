@@ -0,0 +1,29 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ManufacturerIdentifier asocia un World Manufacturer Identifier (primeros 3 caracteres del VIN) con una marca
+type ManufacturerIdentifier struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key"`
+	Code      string    `gorm:"size:3;unique;not null"` // WMI, por ejemplo "JTD"
+	BrandID   uuid.UUID `gorm:"type:uuid;not null"`
+	Brand     Brand     `gorm:"foreignKey:BrandID"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func NewManufacturerIdentifier(code string, brandID uuid.UUID) *ManufacturerIdentifier {
+	return &ManufacturerIdentifier{
+		ID:        uuid.New(),
+		Code:      code,
+		BrandID:   brandID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
@@ -10,8 +10,8 @@ import (
 // Model representa un modelo específico de automóvil
 type Model struct {
 	ID        uuid.UUID `gorm:"type:uuid;primary_key"`
-	Name      string    `gorm:"not null"`
-	BrandID   uuid.UUID `gorm:"type:uuid;not null"`
+	Name      string    `gorm:"not null;uniqueIndex:idx_model_name_brand,where:deleted_at IS NULL"`
+	BrandID   uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_model_name_brand"`
 	Brand     Brand     `gorm:"foreignKey:BrandID"`
 	StartYear int       // Año en que comenzó la producción
 	EndYear   int       // Año en que terminó la producción (0 si sigue en producción)
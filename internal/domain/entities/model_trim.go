@@ -0,0 +1,145 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// FuelType es el combustible o la fuente de energía de una versión
+type FuelType string
+
+const (
+	FuelTypeGasoline     FuelType = "gasoline"
+	FuelTypeDiesel       FuelType = "diesel"
+	FuelTypeHybrid       FuelType = "hybrid"
+	FuelTypePlugInHybrid FuelType = "plugin_hybrid"
+	FuelTypeElectric     FuelType = "electric"
+	FuelTypeLPG          FuelType = "lpg" // Gas licuado de petróleo
+	FuelTypeCNG          FuelType = "cng" // Gas natural comprimido
+)
+
+// FuelTypes son los combustibles aceptados
+var FuelTypes = []FuelType{
+	FuelTypeGasoline,
+	FuelTypeDiesel,
+	FuelTypeHybrid,
+	FuelTypePlugInHybrid,
+	FuelTypeElectric,
+	FuelTypeLPG,
+	FuelTypeCNG,
+}
+
+// IsValid indica si el combustible es uno de los aceptados
+func (f FuelType) IsValid() bool {
+	for _, fuelType := range FuelTypes {
+		if f == fuelType {
+			return true
+		}
+	}
+	return false
+}
+
+// Transmission es el tipo de caja de una versión
+type Transmission string
+
+const (
+	TransmissionManual     Transmission = "manual"
+	TransmissionAutomatic  Transmission = "automatic"
+	TransmissionCVT        Transmission = "cvt"         // Variación continua
+	TransmissionDualClutch Transmission = "dual_clutch" // Doble embrague
+)
+
+// Transmissions son los tipos de caja aceptados
+var Transmissions = []Transmission{
+	TransmissionManual,
+	TransmissionAutomatic,
+	TransmissionCVT,
+	TransmissionDualClutch,
+}
+
+// IsValid indica si el tipo de caja es uno de los aceptados
+func (t Transmission) IsValid() bool {
+	for _, transmission := range Transmissions {
+		if t == transmission {
+			return true
+		}
+	}
+	return false
+}
+
+// Drivetrain es el tipo de tracción de una versión
+type Drivetrain string
+
+const (
+	DrivetrainFWD Drivetrain = "fwd" // Delantera
+	DrivetrainRWD Drivetrain = "rwd" // Trasera
+	DrivetrainAWD Drivetrain = "awd" // Integral permanente
+	Drivetrain4WD Drivetrain = "4wd" // 4x4 conectable
+)
+
+// Drivetrains son los tipos de tracción aceptados
+var Drivetrains = []Drivetrain{
+	DrivetrainFWD,
+	DrivetrainRWD,
+	DrivetrainAWD,
+	Drivetrain4WD,
+}
+
+// IsValid indica si el tipo de tracción es uno de los aceptados
+func (d Drivetrain) IsValid() bool {
+	for _, drivetrain := range Drivetrains {
+		if d == drivetrain {
+			return true
+		}
+	}
+	return false
+}
+
+// ModelTrim representa una versión de un modelo con su ficha técnica
+type ModelTrim struct {
+	ID                 uuid.UUID    `gorm:"type:uuid;primary_key"`
+	ModelID            uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_model_trims_name,where:deleted_at IS NULL"`
+	Model              Model        `gorm:"foreignKey:ModelID"`
+	Name               string       `gorm:"not null;uniqueIndex:idx_model_trims_name"`
+	EngineDisplacement int          // Cilindrada en cm³ (0 para los eléctricos)
+	Power              int          `gorm:"not null"` // Potencia en CV
+	FuelType           FuelType     `gorm:"not null;index"`
+	Transmission       Transmission `gorm:"not null"`
+	Drivetrain         Drivetrain   `gorm:"not null"`
+	Doors              int          `gorm:"not null"`
+	Seats              int          `gorm:"not null"`
+	CurbWeight         int          // Peso en orden de marcha en kg (0 si no se informa)
+	CO2Emissions       int          // Emisiones de CO2 en g/km (0 si no se informa)
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	DeletedAt          gorm.DeletedAt `gorm:"index"`
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (t *ModelTrim) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+func NewModelTrim(modelID uuid.UUID, name string, engineDisplacement, power int, fuelType FuelType, transmission Transmission, drivetrain Drivetrain, doors, seats, curbWeight, co2Emissions int) *ModelTrim {
+	return &ModelTrim{
+		ID:                 uuid.New(),
+		ModelID:            modelID,
+		Name:               name,
+		EngineDisplacement: engineDisplacement,
+		Power:              power,
+		FuelType:           fuelType,
+		Transmission:       transmission,
+		Drivetrain:         drivetrain,
+		Doors:              doors,
+		Seats:              seats,
+		CurbWeight:         curbWeight,
+		CO2Emissions:       co2Emissions,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+}
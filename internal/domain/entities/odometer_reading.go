@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OdometerSource indica el origen de una lectura de kilometraje
+type OdometerSource string
+
+const (
+	OdometerSourceService    OdometerSource = "service"    // Tomada de un registro de servicio
+	OdometerSourceInspection OdometerSource = "inspection" // Tomada de una inspección técnica
+	OdometerSourceManual     OdometerSource = "manual"     // Cargada manualmente
+)
+
+// OdometerAnomaly describe una inconsistencia detectada en una lectura
+type OdometerAnomaly string
+
+const (
+	OdometerAnomalyNone            OdometerAnomaly = ""
+	OdometerAnomalyDecrease        OdometerAnomaly = "decrease"         // Menor a una lectura anterior
+	OdometerAnomalyImplausibleJump OdometerAnomaly = "implausible_jump" // Incremento imposible para el tiempo transcurrido
+)
+
+// OdometerReading representa una lectura del odómetro de un vehículo en una fecha
+type OdometerReading struct {
+	ID        uuid.UUID       `gorm:"type:uuid;primary_key"`
+	CarID     uuid.UUID       `gorm:"type:uuid;not null;index"`
+	Date      time.Time       `gorm:"not null"`
+	Value     int             `gorm:"not null"` // Kilometraje leído
+	Source    OdometerSource  `gorm:"not null"`
+	SourceID  *uuid.UUID      `gorm:"type:uuid;index"` // Registro que originó la lectura; nulo para las manuales
+	Anomaly   OdometerAnomaly // Vacío si la lectura es consistente con el resto de la serie
+	Notes     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (r *OdometerReading) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+func NewOdometerReading(carID uuid.UUID, date time.Time, value int, source OdometerSource, sourceID *uuid.UUID, notes string) *OdometerReading {
+	return &OdometerReading{
+		ID:        uuid.New(),
+		CarID:     carID,
+		Date:      date,
+		Value:     value,
+		Source:    source,
+		SourceID:  sourceID,
+		Notes:     notes,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
@@ -10,7 +10,7 @@ import (
 type Owner struct {
 	ID        uuid.UUID `gorm:"type:uuid;primary_key"`
 	Name      string    `gorm:"not null"`
-	Email     string    `gorm:"unique;not null"`
+	Email     string    `gorm:"not null;uniqueIndex:idx_owners_email,where:deleted_at IS NULL"`
 	Phone     string
 	Address   string
 	Cars      []Car `gorm:"foreignKey:OwnerID"`
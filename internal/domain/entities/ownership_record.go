@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OwnershipRecord representa el período durante el cual un propietario tuvo un vehículo.
+// El registro vigente es el que no tiene fecha de fin.
+type OwnershipRecord struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key"`
+	CarID     uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Car       Car        `gorm:"foreignKey:CarID"`
+	OwnerID   uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Owner     Owner      `gorm:"foreignKey:OwnerID"`
+	From      time.Time  `gorm:"column:from_date;not null"`
+	To        *time.Time `gorm:"column:to_date"` // Nulo mientras el propietario sea el actual
+	Price     *float64   // Precio de la operación que dio inicio al período, si se informó
+	Notes     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (r *OwnershipRecord) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsCurrent indica si el registro corresponde al propietario actual
+func (r *OwnershipRecord) IsCurrent() bool {
+	return r.To == nil
+}
+
+// Close cierra el período de propiedad en la fecha indicada
+func (r *OwnershipRecord) Close(to time.Time) {
+	r.To = &to
+	r.UpdatedAt = time.Now()
+}
+
+func NewOwnershipRecord(carID, ownerID uuid.UUID, from time.Time, price *float64, notes string) *OwnershipRecord {
+	return &OwnershipRecord{
+		ID:        uuid.New(),
+		CarID:     carID,
+		OwnerID:   ownerID,
+		From:      from,
+		Price:     price,
+		Notes:     notes,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,120 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RecallRemedyStatus es el estado de la reparación de un vehículo alcanzado por una campaña
+type RecallRemedyStatus string
+
+const (
+	RecallRemedyOpen     RecallRemedyStatus = "open"     // Reparación pendiente
+	RecallRemedyRemedied RecallRemedyStatus = "remedied" // Reparación realizada
+)
+
+// IsValid indica si el estado es uno de los aceptados
+func (s RecallRemedyStatus) IsValid() bool {
+	return s == RecallRemedyOpen || s == RecallRemedyRemedied
+}
+
+// Recall representa una campaña de llamado a revisión (recall) emitida por el fabricante para un modelo.
+// Los años y el rango de VIN son opcionales y acotan los vehículos afectados.
+type Recall struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key"`
+	ModelID     uuid.UUID `gorm:"type:uuid;not null;index"`
+	Model       Model     `gorm:"foreignKey:ModelID"`
+	Campaign    string    `gorm:"not null;uniqueIndex:idx_recalls_campaign,where:deleted_at IS NULL"` // Número de campaña del fabricante
+	Description string    `gorm:"not null"`
+	Component   string    // Componente o sistema afectado
+	Remedy      string    // Reparación que debe realizarse
+	YearFrom    int       // Primer año afectado (0 sin límite)
+	YearTo      int       // Último año afectado (0 sin límite)
+	VINFrom     string    `gorm:"size:17"` // Primer VIN afectado, si la campaña se limita a un rango
+	VINTo       string    `gorm:"size:17"` // Último VIN afectado, si la campaña se limita a un rango
+	IssuedAt    time.Time `gorm:"not null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+}
+
+// CarRecall vincula un vehículo afectado con la campaña y registra si se realizó la reparación
+type CarRecall struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key"`
+	RecallID   uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_car_recalls_recall_car"`
+	Recall     Recall     `gorm:"foreignKey:RecallID"`
+	CarID      uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_car_recalls_recall_car;index"`
+	Car        Car        `gorm:"foreignKey:CarID"`
+	RemediedAt *time.Time // Nulo mientras la reparación esté pendiente
+	Workshop   string     // Taller que realizó la reparación
+	Notes      string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (r *Recall) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (r *CarRecall) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsOpen indica si la reparación del vehículo sigue pendiente
+func (r *CarRecall) IsOpen() bool {
+	return r.RemediedAt == nil
+}
+
+// RemedyStatus retorna el estado de la reparación del vehículo
+func (r *CarRecall) RemedyStatus() RecallRemedyStatus {
+	if r.IsOpen() {
+		return RecallRemedyOpen
+	}
+	return RecallRemedyRemedied
+}
+
+// Remedy registra la reparación del vehículo
+func (r *CarRecall) Remedy(date time.Time, workshop, notes string) {
+	r.RemediedAt = &date
+	r.Workshop = workshop
+	r.Notes = notes
+	r.UpdatedAt = time.Now()
+}
+
+func NewRecall(modelID uuid.UUID, campaign, description, component, remedy string, yearFrom, yearTo int, vinFrom, vinTo string, issuedAt time.Time) *Recall {
+	return &Recall{
+		ID:          uuid.New(),
+		ModelID:     modelID,
+		Campaign:    campaign,
+		Description: description,
+		Component:   component,
+		Remedy:      remedy,
+		YearFrom:    yearFrom,
+		YearTo:      yearTo,
+		VINFrom:     vinFrom,
+		VINTo:       vinTo,
+		IssuedAt:    issuedAt,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+func NewCarRecall(recallID, carID uuid.UUID) *CarRecall {
+	return &CarRecall{
+		ID:        uuid.New(),
+		RecallID:  recallID,
+		CarID:     carID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
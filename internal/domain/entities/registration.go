@@ -0,0 +1,67 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Registration representa la patente asignada a un vehículo en un país.
+// Una patente está activa mientras no haya sido dada de baja; cada par país-patente
+// puede estar activo en un único vehículo.
+type Registration struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primary_key"`
+	CarID       uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Plate       string     `gorm:"not null;uniqueIndex:idx_registrations_active_plate,where:cancelled_at IS NULL AND deleted_at IS NULL"`
+	Country     string     `gorm:"size:2;not null;uniqueIndex:idx_registrations_active_plate"` // Código ISO 3166-1 alfa-2
+	Region      string     // Provincia o estado de radicación
+	Format      string     // Formato de la patente según el validador del país (mercosur, legacy, etc.)
+	IssuedAt    time.Time  `gorm:"not null"`
+	ExpiresAt   *time.Time // Nulo si la patente no vence
+	CancelledAt *time.Time // Fecha de baja; nulo mientras la patente esté activa
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (r *Registration) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive indica si la patente no fue dada de baja. El vencimiento no la desactiva: una patente
+// vencida sigue asignada al vehículo y reservada para él hasta que se registre su baja, igual que
+// en el índice único de patentes activas. El vencimiento se informa por separado con IsExpired.
+func (r *Registration) IsActive() bool {
+	return r.CancelledAt == nil
+}
+
+// IsExpired indica si la patente está vencida en la fecha indicada
+func (r *Registration) IsExpired(at time.Time) bool {
+	return r.ExpiresAt != nil && r.ExpiresAt.Before(at)
+}
+
+// Cancel da de baja la patente
+func (r *Registration) Cancel(date time.Time) {
+	r.CancelledAt = &date
+	r.UpdatedAt = time.Now()
+}
+
+func NewRegistration(carID uuid.UUID, plate, country, region, format string, issuedAt time.Time, expiresAt *time.Time) *Registration {
+	return &Registration{
+		ID:        uuid.New(),
+		CarID:     carID,
+		Plate:     plate,
+		Country:   country,
+		Region:    region,
+		Format:    format,
+		IssuedAt:  issuedAt,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
@@ -0,0 +1,127 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ServiceType clasifica el trabajo realizado en un servicio
+type ServiceType string
+
+const (
+	ServiceTypeMaintenance ServiceType = "maintenance" // Mantenimiento programado
+	ServiceTypeRepair      ServiceType = "repair"      // Reparación de una falla
+	ServiceTypeTires       ServiceType = "tires"       // Neumáticos, alineación y balanceo
+	ServiceTypeBodywork    ServiceType = "bodywork"    // Chapa y pintura
+	ServiceTypeOther       ServiceType = "other"
+)
+
+// ServiceTypes son los tipos de servicio aceptados
+var ServiceTypes = []ServiceType{
+	ServiceTypeMaintenance,
+	ServiceTypeRepair,
+	ServiceTypeTires,
+	ServiceTypeBodywork,
+	ServiceTypeOther,
+}
+
+// IsValid indica si el tipo de servicio es uno de los aceptados
+func (t ServiceType) IsValid() bool {
+	for _, serviceType := range ServiceTypes {
+		if t == serviceType {
+			return true
+		}
+	}
+	return false
+}
+
+// ServiceRecord representa un servicio o mantenimiento realizado a un vehículo
+type ServiceRecord struct {
+	ID              uuid.UUID         `gorm:"type:uuid;primary_key"`
+	CarID           uuid.UUID         `gorm:"type:uuid;not null;index"`
+	Car             Car               `gorm:"foreignKey:CarID"`
+	Date            time.Time         `gorm:"not null"`
+	Odometer        int               `gorm:"not null"` // Kilometraje al momento del servicio
+	Workshop        string            `gorm:"not null"`
+	Type            ServiceType       `gorm:"not null"`
+	ComponentGroup  ComponentGroup    // Grupo de componentes intervenido (vacío si no se informa)
+	WarrantyCovered bool              `gorm:"not null;default:false"` // Si el trabajo quedó cubierto por la garantía de fábrica
+	LineItems       []ServiceLineItem `gorm:"foreignKey:ServiceRecordID;constraint:OnDelete:CASCADE"`
+	Cost            float64           // Costo total; si hay ítems es la suma de sus importes
+	Notes           string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
+}
+
+// ServiceLineItem representa un repuesto o trabajo facturado dentro de un servicio
+type ServiceLineItem struct {
+	ID              uuid.UUID `gorm:"type:uuid;primary_key"`
+	ServiceRecordID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Description     string    `gorm:"not null"`
+	Quantity        float64   `gorm:"not null"`
+	UnitPrice       float64   `gorm:"not null"`
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (r *ServiceRecord) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (i *ServiceLineItem) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// Total retorna el importe del ítem
+func (i *ServiceLineItem) Total() float64 {
+	return i.Quantity * i.UnitPrice
+}
+
+// SetLineItems reemplaza los ítems del servicio y recalcula el costo total.
+// Sin ítems se conserva el costo informado.
+func (r *ServiceRecord) SetLineItems(items []ServiceLineItem) {
+	r.LineItems = items
+	if len(items) == 0 {
+		return
+	}
+
+	var cost float64
+	for i := range r.LineItems {
+		r.LineItems[i].ServiceRecordID = r.ID
+		cost += r.LineItems[i].Total()
+	}
+	r.Cost = cost
+}
+
+func NewServiceRecord(carID uuid.UUID, date time.Time, odometer int, workshop string, serviceType ServiceType, cost float64, notes string) *ServiceRecord {
+	return &ServiceRecord{
+		ID:        uuid.New(),
+		CarID:     carID,
+		Date:      date,
+		Odometer:  odometer,
+		Workshop:  workshop,
+		Type:      serviceType,
+		Cost:      cost,
+		Notes:     notes,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+func NewServiceLineItem(description string, quantity, unitPrice float64) ServiceLineItem {
+	return ServiceLineItem{
+		ID:          uuid.New(),
+		Description: description,
+		Quantity:    quantity,
+		UnitPrice:   unitPrice,
+	}
+}
@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// StolenReport representa una denuncia de robo sobre un VIN. Puede estar asociada a un
+// vehículo registrado o referirse a un VIN que todavía no existe en el sistema.
+// Mientras no tenga fecha de recupero, el VIN forma parte de la lista de vigilancia.
+type StolenReport struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primary_key"`
+	CarID           *uuid.UUID `gorm:"type:uuid;index"`
+	VIN             string     `gorm:"size:17;not null;index"`
+	ReportDate      time.Time  `gorm:"not null"`
+	PoliceReference string     `gorm:"not null"` // Número de denuncia policial
+	RecoveredDate   *time.Time // Nulo mientras el vehículo no haya sido recuperado
+	Notes           string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       gorm.DeletedAt `gorm:"index"`
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (r *StolenReport) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive indica si la denuncia sigue vigente (el vehículo no fue recuperado)
+func (r *StolenReport) IsActive() bool {
+	return r.RecoveredDate == nil
+}
+
+// Recover registra el recupero del vehículo
+func (r *StolenReport) Recover(date time.Time) {
+	r.RecoveredDate = &date
+	r.UpdatedAt = time.Now()
+}
+
+func NewStolenReport(vin string, carID *uuid.UUID, reportDate time.Time, policeReference, notes string) *StolenReport {
+	return &StolenReport{
+		ID:              uuid.New(),
+		CarID:           carID,
+		VIN:             vin,
+		ReportDate:      reportDate,
+		PoliceReference: policeReference,
+		Notes:           notes,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+}
@@ -0,0 +1,104 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ComponentGroup agrupa los componentes del vehículo alcanzados por una cobertura de garantía
+type ComponentGroup string
+
+const (
+	ComponentGroupGeneral    ComponentGroup = "general"    // Garantía integral: cubre todos los grupos
+	ComponentGroupPowertrain ComponentGroup = "powertrain" // Motor, caja y transmisión
+	ComponentGroupElectrical ComponentGroup = "electrical" // Sistema eléctrico y electrónica
+	ComponentGroupEmissions  ComponentGroup = "emissions"  // Sistema de emisiones
+	ComponentGroupCorrosion  ComponentGroup = "corrosion"  // Perforación por corrosión de la carrocería
+)
+
+// ComponentGroups son los grupos de componentes aceptados
+var ComponentGroups = []ComponentGroup{
+	ComponentGroupGeneral,
+	ComponentGroupPowertrain,
+	ComponentGroupElectrical,
+	ComponentGroupEmissions,
+	ComponentGroupCorrosion,
+}
+
+// IsValid indica si el grupo de componentes es uno de los aceptados
+func (g ComponentGroup) IsValid() bool {
+	for _, group := range ComponentGroups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
+
+// WarrantyTemplate define la garantía de fábrica de una marca o de un modelo. La plantilla del
+// modelo tiene prioridad sobre la de su marca.
+type WarrantyTemplate struct {
+	ID        uuid.UUID          `gorm:"type:uuid;primary_key"`
+	BrandID   uuid.UUID          `gorm:"type:uuid;not null;uniqueIndex:idx_warranty_templates_brand,where:model_id IS NULL AND deleted_at IS NULL"`
+	Brand     Brand              `gorm:"foreignKey:BrandID"`
+	ModelID   *uuid.UUID         `gorm:"type:uuid;uniqueIndex:idx_warranty_templates_model,where:deleted_at IS NULL"` // Nulo si la plantilla aplica a toda la marca
+	Model     *Model             `gorm:"foreignKey:ModelID"`
+	Name      string             `gorm:"not null"`
+	Coverages []WarrantyCoverage `gorm:"foreignKey:WarrantyTemplateID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+// WarrantyCoverage es la cobertura de un grupo de componentes: vence al cumplirse los meses
+// desde el primer patentamiento o al alcanzar el kilometraje límite, lo que ocurra primero
+type WarrantyCoverage struct {
+	ID                 uuid.UUID      `gorm:"type:uuid;primary_key"`
+	WarrantyTemplateID uuid.UUID      `gorm:"type:uuid;not null;index"`
+	ComponentGroup     ComponentGroup `gorm:"not null"`
+	Months             int            `gorm:"not null"`
+	OdometerLimit      int            // Kilometraje límite (0 sin límite)
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (t *WarrantyTemplate) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// BeforeCreate se ejecuta antes de crear un nuevo registro
+func (c *WarrantyCoverage) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+func NewWarrantyTemplate(brandID uuid.UUID, modelID *uuid.UUID, name string, coverages []WarrantyCoverage) *WarrantyTemplate {
+	template := &WarrantyTemplate{
+		ID:        uuid.New(),
+		BrandID:   brandID,
+		ModelID:   modelID,
+		Name:      name,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	for i := range coverages {
+		coverages[i].WarrantyTemplateID = template.ID
+	}
+	template.Coverages = coverages
+	return template
+}
+
+func NewWarrantyCoverage(group ComponentGroup, months, odometerLimit int) WarrantyCoverage {
+	return WarrantyCoverage{
+		ID:             uuid.New(),
+		ComponentGroup: group,
+		Months:         months,
+		OdometerLimit:  odometerLimit,
+	}
+}
@@ -0,0 +1,64 @@
+// Package inspection define cuándo vence la próxima inspección técnica (VTV/ITV) de un vehículo
+package inspection
+
+import (
+	"car-service/internal/domain/entities"
+	"strings"
+	"time"
+)
+
+const (
+	// FirstInspectionAge es la antigüedad en años a la que un vehículo particular debe realizar su primera inspección
+	FirstInspectionAge = 3
+	// AnnualInspectionAge es la antigüedad en años a partir de la cual un vehículo particular se inspecciona cada año
+	AnnualInspectionAge = 10
+	// PrivateIntervalMonths es la frecuencia de inspección de los vehículos particulares más nuevos
+	PrivateIntervalMonths = 24
+	// AnnualIntervalMonths es la frecuencia de inspección de los vehículos comerciales y de los particulares más antiguos
+	AnnualIntervalMonths = 12
+	// ReinspectionDays es el plazo para volver a inspeccionar un vehículo condicional o rechazado
+	ReinspectionDays = 60
+)
+
+// commercialCategories son las categorías de modelo que se inspeccionan cada año desde el primer año
+var commercialCategories = map[string]bool{
+	"pickup":     true,
+	"van":        true,
+	"utilitario": true,
+	"truck":      true,
+	"camion":     true,
+	"camión":     true,
+	"bus":        true,
+	"taxi":       true,
+}
+
+// IsCommercial indica si la categoría del modelo corresponde a un vehículo de uso comercial
+func IsCommercial(category string) bool {
+	return commercialCategories[strings.ToLower(strings.TrimSpace(category))]
+}
+
+// NextDueDate calcula el vencimiento de la próxima inspección a partir de una inspección realizada.
+// Un resultado condicional o rechazado exige reinspección en ReinspectionDays; en otro caso los
+// vehículos comerciales y los particulares con AnnualInspectionAge años o más vencen al año, y el
+// resto de los particulares a los PrivateIntervalMonths meses.
+func NextDueDate(date time.Time, result entities.InspectionResult, carYear int, category string) time.Time {
+	if result != entities.InspectionResultApproved {
+		return date.AddDate(0, 0, ReinspectionDays)
+	}
+
+	if IsCommercial(category) || date.Year()-carYear >= AnnualInspectionAge {
+		return date.AddDate(0, AnnualIntervalMonths, 0)
+	}
+	return date.AddDate(0, PrivateIntervalMonths, 0)
+}
+
+// FirstDueDate calcula el vencimiento de la primera inspección de un vehículo que nunca fue inspeccionado:
+// los comerciales al cumplir un año y los particulares al cumplir FirstInspectionAge años, contados
+// desde el 1 de enero del año de fabricación
+func FirstDueDate(carYear int, category string) time.Time {
+	age := FirstInspectionAge
+	if IsCommercial(category) {
+		age = 1
+	}
+	return time.Date(carYear+age, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,78 @@
+// Package odometer analiza series de lecturas de kilometraje para detectar inconsistencias
+package odometer
+
+import (
+	"car-service/internal/domain/entities"
+	"sort"
+	"time"
+)
+
+const (
+	// MaxAnnualDistance es la distancia máxima plausible que un vehículo recorre en un año
+	MaxAnnualDistance = 100000
+	// Tolerance absorbe diferencias de carga entre lecturas muy cercanas en el tiempo
+	Tolerance = 1000
+)
+
+// Sort ordena las lecturas por fecha y, a igual fecha, por fecha de carga
+func Sort(readings []*entities.OdometerReading) {
+	sort.SliceStable(readings, func(i, j int) bool {
+		if readings[i].Date.Equal(readings[j].Date) {
+			return readings[i].CreatedAt.Before(readings[j].CreatedAt)
+		}
+		return readings[i].Date.Before(readings[j].Date)
+	})
+}
+
+// Analyze ordena las lecturas y asigna a cada una la anomalía detectada.
+// Una lectura es un retroceso si es menor al máximo leído hasta su fecha, y es un salto
+// implausible si el incremento supera MaxAnnualDistance por año transcurrido (desde la lectura
+// anterior o, para la primera, desde el 1 de enero del año de fabricación).
+// Retorna true si alguna lectura tiene anomalías.
+func Analyze(readings []*entities.OdometerReading, carYear int) bool {
+	Sort(readings)
+
+	flagged := false
+	maxValue := 0
+	since := time.Date(carYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for _, reading := range readings {
+		reading.Anomaly = evaluate(reading, maxValue, since)
+		if reading.Anomaly != entities.OdometerAnomalyNone {
+			flagged = true
+			continue
+		}
+		maxValue = reading.Value
+		since = reading.Date
+	}
+	return flagged
+}
+
+func evaluate(reading *entities.OdometerReading, maxValue int, since time.Time) entities.OdometerAnomaly {
+	if reading.Value < maxValue {
+		return entities.OdometerAnomalyDecrease
+	}
+
+	if reading.Value-maxValue > MaxDistance(since, reading.Date) {
+		return entities.OdometerAnomalyImplausibleJump
+	}
+	return entities.OdometerAnomalyNone
+}
+
+// MaxDistance retorna la distancia máxima plausible entre dos fechas
+func MaxDistance(from, to time.Time) int {
+	days := to.Sub(from).Hours() / 24
+	if days < 0 {
+		days = 0
+	}
+	return int(days*MaxAnnualDistance/365) + Tolerance
+}
+
+// Latest retorna la última lectura sin anomalías de una serie analizada, o nil si no hay ninguna
+func Latest(readings []*entities.OdometerReading) *entities.OdometerReading {
+	for i := len(readings) - 1; i >= 0; i-- {
+		if readings[i].Anomaly == entities.OdometerAnomalyNone {
+			return readings[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package pagination
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// Request define la página solicitada; los valores vacíos toman los valores por defecto
+type Request struct {
+	Page     int
+	PageSize int
+}
+
+// Normalize aplica los valores por defecto y limita el tamaño de página
+func (r Request) Normalize() Request {
+	if r.Page < 1 {
+		r.Page = 1
+	}
+	if r.PageSize < 1 {
+		r.PageSize = DefaultPageSize
+	}
+	if r.PageSize > MaxPageSize {
+		r.PageSize = MaxPageSize
+	}
+	return r
+}
+
+// Offset retorna la cantidad de registros a omitir para la página solicitada
+func (r Request) Offset() int {
+	r = r.Normalize()
+	return (r.Page - 1) * r.PageSize
+}
+
+// Metadata describe la página retornada
+type Metadata struct {
+	Page       int   `json:"page"`
+	PageSize   int   `json:"pageSize"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"totalPages"`
+}
+
+// Page contiene los elementos de una página y sus metadatos
+type Page[T any] struct {
+	Items      []T
+	Pagination Metadata
+}
+
+func NewPage[T any](items []T, request Request, total int64) *Page[T] {
+	request = request.Normalize()
+	totalPages := int((total + int64(request.PageSize) - 1) / int64(request.PageSize))
+	if items == nil {
+		items = []T{}
+	}
+	return &Page[T]{
+		Items: items,
+		Pagination: Metadata{
+			Page:       request.Page,
+			PageSize:   request.PageSize,
+			Total:      total,
+			TotalPages: totalPages,
+		},
+	}
+}
+
+// Content retorna los elementos de la página
+func (p *Page[T]) Content() any {
+	return p.Items
+}
+
+// Metadata retorna los metadatos de la página
+func (p *Page[T]) Metadata() Metadata {
+	return p.Pagination
+}
+
+// Map convierte los elementos de una página conservando sus metadatos
+func Map[T any, R any](page *Page[T], convert func(T) R) *Page[R] {
+	items := make([]R, len(page.Items))
+	for i, item := range page.Items {
+		items[i] = convert(item)
+	}
+	return &Page[R]{
+		Items:      items,
+		Pagination: page.Pagination,
+	}
+}
@@ -0,0 +1,42 @@
+// Package period interpreta los períodos a futuro que aceptan las consultas de vencimientos
+package period
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultWindow es el período consultado cuando no se informa uno
+const DefaultWindow = 30 * 24 * time.Hour
+
+// ParseWindow interpreta un período en días ("30d"), semanas ("4w") o como duración de Go ("72h").
+// Un valor vacío retorna DefaultWindow.
+func ParseWindow(value string) (time.Duration, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return DefaultWindow, nil
+	}
+	invalid := fmt.Errorf("Período inválido: %s; use días (30d), semanas (4w) u horas (72h)", value)
+
+	var unit time.Duration
+	switch {
+	case strings.HasSuffix(value, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(value, "w"):
+		unit = 7 * 24 * time.Hour
+	default:
+		window, err := time.ParseDuration(value)
+		if err != nil || window < 0 {
+			return 0, invalid
+		}
+		return window, nil
+	}
+
+	count, err := strconv.Atoi(value[:len(value)-1])
+	if err != nil || count < 0 {
+		return 0, invalid
+	}
+	return time.Duration(count) * unit, nil
+}
@@ -0,0 +1,140 @@
+// Package plate valida patentes (placas de matrícula) según el formato de cada país.
+// Cada país se incorpora registrando un Validator.
+package plate
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrUnsupportedCountry = errors.New("no hay un validador de patentes para el país indicado")
+	ErrInvalidFormat      = errors.New("la patente no respeta ningún formato vigente del país")
+)
+
+// Validator valida las patentes de un país
+type Validator interface {
+	// Country retorna el código ISO 3166-1 alfa-2 del país
+	Country() string
+	// Format retorna el nombre del formato al que corresponde la patente normalizada
+	Format(plate string) (string, error)
+}
+
+var (
+	validators = make(map[string]Validator)
+	mu         sync.RWMutex
+)
+
+// Register incorpora el validador de un país, reemplazando el existente si lo hubiera
+func Register(validator Validator) {
+	mu.Lock()
+	defer mu.Unlock()
+	validators[NormalizeCountry(validator.Country())] = validator
+}
+
+// Countries retorna los códigos de los países con validador registrado
+func Countries() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	countries := make([]string, 0, len(validators))
+	for country := range validators {
+		countries = append(countries, country)
+	}
+	sort.Strings(countries)
+	return countries
+}
+
+// Normalize convierte la patente a mayúsculas y elimina espacios, guiones y puntos
+func Normalize(plate string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.':
+			return -1
+		}
+		return r
+	}, strings.ToUpper(plate))
+}
+
+// NormalizeCountry convierte el código de país a mayúsculas
+func NormalizeCountry(country string) string {
+	return strings.ToUpper(strings.TrimSpace(country))
+}
+
+// Validate normaliza la patente y retorna el formato que le corresponde en el país indicado
+func Validate(country, plate string) (string, error) {
+	mu.RLock()
+	validator, ok := validators[NormalizeCountry(country)]
+	mu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedCountry, country)
+	}
+	return validator.Format(Normalize(plate))
+}
+
+// patternValidator valida patentes contra una lista de patrones donde
+// 'L' representa una letra y 'D' un dígito
+type patternValidator struct {
+	country  string
+	patterns []pattern
+}
+
+type pattern struct {
+	format string
+	layout string
+}
+
+func (v *patternValidator) Country() string {
+	return v.country
+}
+
+func (v *patternValidator) Format(plate string) (string, error) {
+	for _, p := range v.patterns {
+		if matches(p.layout, plate) {
+			return p.format, nil
+		}
+	}
+	return "", ErrInvalidFormat
+}
+
+func matches(layout, plate string) bool {
+	if len(layout) != len(plate) {
+		return false
+	}
+	for i := 0; i < len(layout); i++ {
+		c := plate[i]
+		switch layout[i] {
+		case 'L':
+			if c < 'A' || c > 'Z' {
+				return false
+			}
+		case 'D':
+			if c < '0' || c > '9' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func init() {
+	// Argentina: Mercosur (AA123BB, desde 2016) y formato anterior (ABC123, 1995-2016)
+	Register(&patternValidator{
+		country: "AR",
+		patterns: []pattern{
+			{format: "mercosur", layout: "LLDDDLL"},
+			{format: "legacy", layout: "LLLDDD"},
+		},
+	})
+
+	// Brasil: Mercosur (ABC1D23, desde 2018) y formato anterior (ABC1234)
+	Register(&patternValidator{
+		country: "BR",
+		patterns: []pattern{
+			{format: "mercosur", layout: "LLLDLDD"},
+			{format: "legacy", layout: "LLLDDDD"},
+		},
+	})
+}
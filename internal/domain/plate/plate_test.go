@@ -0,0 +1,73 @@
+package plate
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateByCountry(t *testing.T) {
+	tests := []struct {
+		country string
+		plate   string
+		format  string
+		err     error
+	}{
+		{country: "AR", plate: "AB123CD", format: "mercosur"},
+		{country: "ar", plate: "ab 123 cd", format: "mercosur"},
+		{country: "AR", plate: "ABC-123", format: "legacy"},
+		{country: "AR", plate: "ABC1D23", err: ErrInvalidFormat},
+		{country: "AR", plate: "AB123C", err: ErrInvalidFormat},
+		{country: "BR", plate: "ABC1D23", format: "mercosur"},
+		{country: "BR", plate: "ABC-1234", format: "legacy"},
+		{country: "BR", plate: "AB123CD", err: ErrInvalidFormat},
+		{country: "UY", plate: "SBA1234", err: ErrUnsupportedCountry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.country+" "+tt.plate, func(t *testing.T) {
+			format, err := Validate(tt.country, tt.plate)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Validate(%q, %q) = %v, se esperaba %v", tt.country, tt.plate, err, tt.err)
+			}
+			if format != tt.format {
+				t.Errorf("formato = %q, se esperaba %q", format, tt.format)
+			}
+		})
+	}
+}
+
+// fixedValidator acepta una única patente
+type fixedValidator struct {
+	country string
+	plate   string
+}
+
+func (v fixedValidator) Country() string {
+	return v.country
+}
+
+func (v fixedValidator) Format(plate string) (string, error) {
+	if plate != v.plate {
+		return "", ErrInvalidFormat
+	}
+	return "test", nil
+}
+
+func TestRegisterAddsCountry(t *testing.T) {
+	Register(fixedValidator{country: "zz", plate: "TEST1"})
+	t.Cleanup(func() {
+		mu.Lock()
+		defer mu.Unlock()
+		delete(validators, "ZZ")
+	})
+
+	if format, err := Validate("ZZ", "test-1"); err != nil || format != "test" {
+		t.Errorf("Validate = %q, %v; se esperaba el formato del validador registrado", format, err)
+	}
+	found := false
+	for _, country := range Countries() {
+		found = found || country == "ZZ"
+	}
+	if !found {
+		t.Errorf("Countries() = %v, se esperaba incluir ZZ", Countries())
+	}
+}
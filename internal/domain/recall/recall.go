@@ -0,0 +1,44 @@
+// Package recall determina qué vehículos quedan alcanzados por una campaña de llamado a revisión
+package recall
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/vin"
+)
+
+// Affects indica si la campaña alcanza al vehículo: debe ser del modelo de la campaña, estar dentro
+// del rango de años y, si la campaña define un rango de VIN, dentro de ese rango
+func Affects(recall *entities.Recall, car *entities.Car) bool {
+	if car.ModelID != recall.ModelID {
+		return false
+	}
+	if recall.YearFrom != 0 && car.Year < recall.YearFrom {
+		return false
+	}
+	if recall.YearTo != 0 && car.Year > recall.YearTo {
+		return false
+	}
+	return InVINRange(car.VIN, recall.VINFrom, recall.VINTo)
+}
+
+// InVINRange indica si el VIN está dentro del rango [from, to]; un extremo vacío no limita el rango.
+// Los VIN se comparan sin el dígito verificador (posición 9), que no sigue el orden de fabricación.
+func InVINRange(value, from, to string) bool {
+	key := sequenceKey(value)
+	if from != "" && key < sequenceKey(from) {
+		return false
+	}
+	if to != "" && key > sequenceKey(to) {
+		return false
+	}
+	return true
+}
+
+// sequenceKey retorna el VIN normalizado sin el dígito verificador
+func sequenceKey(value string) string {
+	value = vin.Normalize(value)
+	if len(value) != vin.Length {
+		return value
+	}
+	return value[:8] + value[9:]
+}
@@ -0,0 +1,65 @@
+package recall
+
+import (
+	"car-service/internal/domain/entities"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestInVINRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		vin      string
+		from, to string
+		expected bool
+	}{
+		{name: "sin rango", vin: "JTDBR32E3L0123456", expected: true},
+		{name: "dentro del rango", vin: "JTDBR32E3L0123456", from: "JTDBR32E0L0100000", to: "JTDBR32E0L0199999", expected: true},
+		{name: "en el extremo inicial", vin: "JTDBR32E3L0100000", from: "JTDBR32E0L0100000", to: "JTDBR32E0L0199999", expected: true},
+		{name: "en el extremo final", vin: "JTDBR32E3L0199999", from: "JTDBR32E0L0100000", to: "JTDBR32E0L0199999", expected: true},
+		{name: "antes del rango", vin: "JTDBR32E3L0099999", from: "JTDBR32E0L0100000", to: "JTDBR32E0L0199999"},
+		{name: "después del rango", vin: "JTDBR32E3L0200000", from: "JTDBR32E0L0100000", to: "JTDBR32E0L0199999"},
+		{name: "solo extremo inicial", vin: "JTDBR32E3M0000001", from: "JTDBR32E0L0100000", expected: true},
+		{name: "solo extremo final", vin: "JTDBR32E3M0000001", to: "JTDBR32E0L0199999"},
+		{name: "ignora el dígito verificador", vin: "JTDBR32EXL0100000", from: "JTDBR32E0L0100000", to: "JTDBR32E9L0100000", expected: true},
+		{name: "en minúsculas", vin: "jtdbr32e3l0123456", from: "JTDBR32E0L0100000", to: "JTDBR32E0L0199999", expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InVINRange(tt.vin, tt.from, tt.to); got != tt.expected {
+				t.Errorf("InVINRange(%q, %q, %q) = %v, se esperaba %v", tt.vin, tt.from, tt.to, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAffects(t *testing.T) {
+	modelID := uuid.New()
+	recall := entities.NewRecall(modelID, "R-1", "", "Airbag", "", 2018, 2020, "JTDBR32E0L0100000", "JTDBR32E0L0199999", time.Now())
+	openYears := entities.NewRecall(modelID, "R-2", "", "Airbag", "", 0, 0, "", "", time.Now())
+
+	tests := []struct {
+		name     string
+		recall   *entities.Recall
+		car      *entities.Car
+		expected bool
+	}{
+		{name: "modelo, año y VIN dentro", recall: recall, car: entities.NewCar(modelID, 2019, "Rojo", "JTDBR32E3L0123456", uuid.New()), expected: true},
+		{name: "año en el extremo inicial", recall: recall, car: entities.NewCar(modelID, 2018, "Rojo", "JTDBR32E3L0123456", uuid.New()), expected: true},
+		{name: "año en el extremo final", recall: recall, car: entities.NewCar(modelID, 2020, "Rojo", "JTDBR32E3L0123456", uuid.New()), expected: true},
+		{name: "año anterior", recall: recall, car: entities.NewCar(modelID, 2017, "Rojo", "JTDBR32E3L0123456", uuid.New())},
+		{name: "año posterior", recall: recall, car: entities.NewCar(modelID, 2021, "Rojo", "JTDBR32E3L0123456", uuid.New())},
+		{name: "VIN fuera del rango", recall: recall, car: entities.NewCar(modelID, 2019, "Rojo", "JTDBR32E3L0223456", uuid.New())},
+		{name: "otro modelo", recall: recall, car: entities.NewCar(uuid.New(), 2019, "Rojo", "JTDBR32E3L0123456", uuid.New())},
+		{name: "campaña sin rangos", recall: openYears, car: entities.NewCar(modelID, 2005, "Rojo", "WVWZZZ1J93W386752", uuid.New()), expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Affects(tt.recall, tt.car); got != tt.expected {
+				t.Errorf("Affects = %v, se esperaba %v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -1,16 +1,18 @@
 package repositories
 
 import (
-	"github.com/google/uuid"
 	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
 )
 
 type BrandRepository interface {
-	Create(brand *entities.Brand) error
-	GetByID(id uuid.UUID) (*entities.Brand, error)
-	GetByName(name string) (*entities.Brand, error)
-	Update(brand *entities.Brand) error
-	Delete(id uuid.UUID) error
-	List() ([]*entities.Brand, error)
-	ListActive() ([]*entities.Brand, error)
-} 
\ No newline at end of file
+	Create(ctx context.Context, brand *entities.Brand) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Brand, error)
+	GetByName(ctx context.Context, name string) (*entities.Brand, error)
+	Update(ctx context.Context, brand *entities.Brand) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context) ([]*entities.Brand, error)
+	ListActive(ctx context.Context) ([]*entities.Brand, error)
+}
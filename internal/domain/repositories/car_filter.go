@@ -0,0 +1,37 @@
+package repositories
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/pagination"
+
+	"github.com/google/uuid"
+)
+
+// CarSortFields son los campos por los que se pueden ordenar los autos
+var CarSortFields = []string{"createdAt", "updatedAt", "year", "color", "vin"}
+
+// CarRelations indica qué relaciones se cargan junto a cada auto
+type CarRelations struct {
+	Model bool
+	Brand bool // Implica cargar el modelo
+	Trim  bool
+	Owner bool
+}
+
+// CarFilter define los criterios opcionales para buscar autos; los valores vacíos no filtran
+type CarFilter struct {
+	BrandID   uuid.UUID
+	ModelID   uuid.UUID
+	OwnerID   uuid.UUID
+	YearFrom  int
+	YearTo    int
+	Color     string
+	Status    entities.CarStatus
+	Active    *bool      // true: estados no finales; false: estados finales
+	Plate     string     // Patente activa normalizada, en cualquier país
+	Specs     SpecFilter // Ficha técnica de la versión del auto; los autos sin versión no coinciden
+	SortField string     // Uno de CarSortFields; por defecto createdAt
+	SortDesc  bool
+	Page      pagination.Request
+	Relations CarRelations
+}
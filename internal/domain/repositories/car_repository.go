@@ -10,10 +10,16 @@ import (
 // CarRepository define las operaciones de persistencia para los autos
 type CarRepository interface {
 	Create(ctx context.Context, car *entities.Car) (*entities.Car, error)
-	GetByID(id uuid.UUID) (*entities.Car, error)
-	GetByVIN(vin string) (*entities.Car, error)
-	Update(car *entities.Car) error
-	Delete(id uuid.UUID) error
-	GetByOwnerID(ownerID uuid.UUID) ([]*entities.Car, error)
-	List() ([]*entities.Car, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Car, error)
+	GetByIDWithRelations(ctx context.Context, id uuid.UUID, relations CarRelations) (*entities.Car, error)
+	GetByVIN(ctx context.Context, vin string) (*entities.Car, error)
+	Update(ctx context.Context, car *entities.Car) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*entities.Car, error)
+	CountByModelID(ctx context.Context, modelID uuid.UUID) (int64, error)
+	CountByTrimID(ctx context.Context, trimID uuid.UUID) (int64, error)
+	// ListByModelYears retorna los autos del modelo dentro del rango de años; un año en 0 no limita el rango
+	ListByModelYears(ctx context.Context, modelID uuid.UUID, yearFrom, yearTo int) ([]*entities.Car, error)
+	List(ctx context.Context) ([]*entities.Car, error)
+	Search(ctx context.Context, filter CarFilter) ([]*entities.Car, int64, error)
 }
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// CarStatusTransitionRepository define las operaciones de persistencia para el historial de estados
+type CarStatusTransitionRepository interface {
+	Create(ctx context.Context, transition *entities.CarStatusTransition) error
+	ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.CarStatusTransition, error)
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InspectionRepository define las operaciones de persistencia para las inspecciones técnicas
+type InspectionRepository interface {
+	Create(ctx context.Context, inspection *entities.Inspection) error
+	GetByID(ctx context.Context, carID, id uuid.UUID) (*entities.Inspection, error)
+	ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.Inspection, error)
+	// ListLatestDue retorna la última inspección de cada auto cuyo vencimiento es anterior o igual a until,
+	// con el auto y su modelo cargados; se omiten los autos en los estados excluidos
+	ListLatestDue(ctx context.Context, until time.Time, excluded []entities.CarStatus) ([]*entities.Inspection, error)
+	// ListUninspectedCars retorna los autos sin inspecciones con su modelo cargado; se omiten los autos en los estados excluidos
+	ListUninspectedCars(ctx context.Context, excluded []entities.CarStatus) ([]*entities.Car, error)
+}
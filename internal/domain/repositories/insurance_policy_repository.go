@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CarCoverage es la cobertura de seguro de un auto a una fecha
+type CarCoverage struct {
+	Car          *entities.Car
+	Policy       *entities.InsurancePolicy // Póliza vigente; nil si el auto no está asegurado
+	CoveredUntil *time.Time                // Fin de la cobertura continua; nil si el auto no está asegurado
+}
+
+// InsurancePolicyRepository define las operaciones de persistencia para las pólizas de seguro
+type InsurancePolicyRepository interface {
+	Create(ctx context.Context, policy *entities.InsurancePolicy) error
+	GetByID(ctx context.Context, carID, id uuid.UUID) (*entities.InsurancePolicy, error)
+	Update(ctx context.Context, policy *entities.InsurancePolicy) error
+	// GetByNumber retorna nil sin error si la aseguradora no tiene una póliza con ese número
+	GetByNumber(ctx context.Context, insurer, policyNumber string) (*entities.InsurancePolicy, error)
+	// GetOverlapping retorna nil sin error si ninguna póliza del auto cubre parte del período [start, end)
+	GetOverlapping(ctx context.Context, carID uuid.UUID, start, end time.Time) (*entities.InsurancePolicy, error)
+	ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.InsurancePolicy, error)
+	ListByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*entities.InsurancePolicy, error)
+	// ListCoverageAlerts retorna los autos que no están en un estado final y que en la fecha at no tienen
+	// una póliza vigente o cuya cobertura continua, encadenando las renovaciones, termina hasta until.
+	// Primero se listan los autos sin seguro y luego los asegurados por fin de cobertura.
+	ListCoverageAlerts(ctx context.Context, at, until time.Time) ([]*CarCoverage, error)
+	// ListUnexpiredByCarID retorna las pólizas del auto cuya cobertura termina después de la fecha indicada
+	ListUnexpiredByCarID(ctx context.Context, carID uuid.UUID, at time.Time) ([]*entities.InsurancePolicy, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type ManufacturerIdentifierRepository interface {
+	Create(ctx context.Context, identifier *entities.ManufacturerIdentifier) error
+	// GetByCode retorna nil sin error si el código no está registrado
+	GetByCode(ctx context.Context, code string) (*entities.ManufacturerIdentifier, error)
+	GetByBrandID(ctx context.Context, brandID uuid.UUID) ([]*entities.ManufacturerIdentifier, error)
+}
@@ -2,19 +2,22 @@ package repositories
 
 import (
 	"car-service/internal/domain/entities"
+	"context"
 
 	"github.com/google/uuid"
 )
 
 type ModelRepository interface {
-	Create(model *entities.Model) error
-	GetByID(id uuid.UUID) (*entities.Model, error)
-	ExistsByID(id uuid.UUID) (bool, error)
-	GetByBrandID(brandID uuid.UUID) ([]*entities.Model, error)
-	GetByNameAndBrand(name string, brandID uuid.UUID) (*entities.Model, error)
-	Update(model *entities.Model) error
-	Delete(id uuid.UUID) error
-	List() ([]*entities.Model, error)
-	ListActive() ([]*entities.Model, error)
-	ListByCategory(category string) ([]*entities.Model, error)
+	Create(ctx context.Context, model *entities.Model) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Model, error)
+	ExistsByID(ctx context.Context, id uuid.UUID) (bool, error)
+	GetByBrandID(ctx context.Context, brandID uuid.UUID) ([]*entities.Model, error)
+	GetByNameAndBrand(ctx context.Context, name string, brandID uuid.UUID) (*entities.Model, error)
+	Update(ctx context.Context, model *entities.Model) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context) ([]*entities.Model, error)
+	ListActive(ctx context.Context) ([]*entities.Model, error)
+	ListByCategory(ctx context.Context, category string) ([]*entities.Model, error)
+	// ListBySpecs retorna los modelos con al menos una versión que cumple el filtro
+	ListBySpecs(ctx context.Context, specs SpecFilter) ([]*entities.Model, error)
 }
@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ModelTrimRepository define las operaciones de persistencia para las versiones de los modelos
+type ModelTrimRepository interface {
+	Create(ctx context.Context, trim *entities.ModelTrim) error
+	GetByID(ctx context.Context, modelID, id uuid.UUID) (*entities.ModelTrim, error)
+	// GetByNameAndModel retorna nil sin error si el modelo no tiene una versión con ese nombre
+	GetByNameAndModel(ctx context.Context, name string, modelID uuid.UUID) (*entities.ModelTrim, error)
+	Update(ctx context.Context, trim *entities.ModelTrim) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListByModelID(ctx context.Context, modelID uuid.UUID) ([]*entities.ModelTrim, error)
+}
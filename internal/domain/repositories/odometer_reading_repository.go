@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// OdometerReadingRepository define las operaciones de persistencia para las lecturas de kilometraje
+type OdometerReadingRepository interface {
+	Create(ctx context.Context, reading *entities.OdometerReading) error
+	Update(ctx context.Context, reading *entities.OdometerReading) error
+	// GetBySource retorna nil sin error si no hay una lectura asociada al registro de origen
+	GetBySource(ctx context.Context, source entities.OdometerSource, sourceID uuid.UUID) (*entities.OdometerReading, error)
+	DeleteBySource(ctx context.Context, source entities.OdometerSource, sourceID uuid.UUID) error
+	ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.OdometerReading, error)
+}
@@ -2,16 +2,17 @@ package repositories
 
 import (
 	"car-service/internal/domain/entities"
+	"context"
 
 	"github.com/google/uuid"
 )
 
 type OwnerRepository interface {
-	Create(owner *entities.Owner) error
-	GetByID(id uuid.UUID) (*entities.Owner, error)
-	ExistsByID(id uuid.UUID) (bool, error)
-	GetByEmail(email string) (*entities.Owner, error)
-	Update(owner *entities.Owner) error
-	Delete(id uuid.UUID) error
-	List() ([]*entities.Owner, error)
+	Create(ctx context.Context, owner *entities.Owner) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Owner, error)
+	ExistsByID(ctx context.Context, id uuid.UUID) (bool, error)
+	GetByEmail(ctx context.Context, email string) (*entities.Owner, error)
+	Update(ctx context.Context, owner *entities.Owner) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context) ([]*entities.Owner, error)
 }
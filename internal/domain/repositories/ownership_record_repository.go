@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// OwnershipRecordRepository define las operaciones de persistencia para el historial de propietarios
+type OwnershipRecordRepository interface {
+	Create(ctx context.Context, record *entities.OwnershipRecord) error
+	Update(ctx context.Context, record *entities.OwnershipRecord) error
+	// GetCurrentByCarID retorna nil sin error si el auto no tiene un registro vigente
+	GetCurrentByCarID(ctx context.Context, carID uuid.UUID) (*entities.OwnershipRecord, error)
+	ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.OwnershipRecord, error)
+}
@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// RecallRepository define las operaciones de persistencia para las campañas de llamado a revisión
+type RecallRepository interface {
+	Create(ctx context.Context, recall *entities.Recall) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.Recall, error)
+	// GetByCampaign retorna nil sin error si no existe una campaña con ese número
+	GetByCampaign(ctx context.Context, campaign string) (*entities.Recall, error)
+	ListByModelID(ctx context.Context, modelID uuid.UUID) ([]*entities.Recall, error)
+}
+
+// CarRecallRepository define las operaciones de persistencia para los vehículos alcanzados por una campaña
+type CarRecallRepository interface {
+	CreateBatch(ctx context.Context, carRecalls []*entities.CarRecall) error
+	GetByCarAndRecall(ctx context.Context, carID, recallID uuid.UUID) (*entities.CarRecall, error)
+	Update(ctx context.Context, carRecall *entities.CarRecall) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// ListByCarID retorna las campañas que alcanzan al auto con la campaña cargada
+	ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.CarRecall, error)
+	// ListByRecallID retorna los autos alcanzados por la campaña con el auto cargado
+	ListByRecallID(ctx context.Context, recallID uuid.UUID) ([]*entities.CarRecall, error)
+}
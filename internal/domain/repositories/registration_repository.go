@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// RegistrationRepository define las operaciones de persistencia para las patentes
+type RegistrationRepository interface {
+	Create(ctx context.Context, registration *entities.Registration) error
+	GetByID(ctx context.Context, carID, id uuid.UUID) (*entities.Registration, error)
+	Update(ctx context.Context, registration *entities.Registration) error
+	// GetActiveByPlate retorna nil sin error si la patente no está activa en ningún vehículo
+	GetActiveByPlate(ctx context.Context, country, plate string) (*entities.Registration, error)
+	// GetActiveByCarID retorna nil sin error si el auto no tiene una patente activa
+	GetActiveByCarID(ctx context.Context, carID uuid.UUID) (*entities.Registration, error)
+	ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.Registration, error)
+}
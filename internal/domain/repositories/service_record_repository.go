@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ServiceRecordRepository define las operaciones de persistencia para el historial de servicios
+type ServiceRecordRepository interface {
+	Create(ctx context.Context, record *entities.ServiceRecord) error
+	GetByID(ctx context.Context, carID, id uuid.UUID) (*entities.ServiceRecord, error)
+	Update(ctx context.Context, record *entities.ServiceRecord) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.ServiceRecord, error)
+}
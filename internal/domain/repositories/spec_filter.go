@@ -0,0 +1,23 @@
+package repositories
+
+import "car-service/internal/domain/entities"
+
+// SpecFilter define criterios opcionales sobre la ficha técnica de una versión; los valores vacíos no filtran
+type SpecFilter struct {
+	FuelType         entities.FuelType
+	Transmission     entities.Transmission
+	Drivetrain       entities.Drivetrain
+	Doors            int
+	Seats            int // Cantidad mínima de plazas
+	PowerFrom        int
+	PowerTo          int
+	DisplacementFrom int
+	DisplacementTo   int
+	CurbWeightTo     int
+	CO2To            int // Emisiones máximas; excluye las versiones sin emisiones informadas salvo las eléctricas
+}
+
+// IsEmpty indica si el filtro no tiene ningún criterio
+func (f SpecFilter) IsEmpty() bool {
+	return f == SpecFilter{}
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// StolenReportRepository define las operaciones de persistencia para las denuncias de robo
+type StolenReportRepository interface {
+	Create(ctx context.Context, report *entities.StolenReport) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.StolenReport, error)
+	Update(ctx context.Context, report *entities.StolenReport) error
+	// GetActiveByVIN retorna nil sin error si el VIN no tiene una denuncia vigente
+	GetActiveByVIN(ctx context.Context, vin string) (*entities.StolenReport, error)
+	ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.StolenReport, error)
+}
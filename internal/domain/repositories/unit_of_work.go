@@ -0,0 +1,11 @@
+package repositories
+
+import "context"
+
+// UnitOfWork agrupa las operaciones de persistencia de un caso de uso en una única transacción.
+// La transacción viaja dentro del contexto retornado por Begin y los repositorios la resuelven desde allí.
+type UnitOfWork interface {
+	Begin(ctx context.Context) (context.Context, error)
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// WarrantyTemplateRepository define las operaciones de persistencia para las plantillas de garantía
+type WarrantyTemplateRepository interface {
+	Create(ctx context.Context, template *entities.WarrantyTemplate) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.WarrantyTemplate, error)
+	// GetByModelID retorna nil sin error si el modelo no tiene una plantilla propia
+	GetByModelID(ctx context.Context, modelID uuid.UUID) (*entities.WarrantyTemplate, error)
+	// GetByBrandID retorna nil sin error si la marca no tiene una plantilla general
+	GetByBrandID(ctx context.Context, brandID uuid.UUID) (*entities.WarrantyTemplate, error)
+	List(ctx context.Context) ([]*entities.WarrantyTemplate, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,18 @@
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// BrandService define las operaciones disponibles para las marcas
+type BrandService interface {
+	CreateBrand(ctx context.Context, brand *entities.Brand) (*entities.Brand, error)
+	GetBrands(ctx context.Context) ([]*entities.Brand, error)
+	GetBrand(ctx context.Context, id uuid.UUID) (*entities.Brand, error)
+	UpdateBrand(ctx context.Context, brand *entities.Brand) (*entities.Brand, error)
+	DeactivateBrand(ctx context.Context, id uuid.UUID) (*entities.Brand, error)
+	DeleteBrand(ctx context.Context, id uuid.UUID) error
+}
@@ -2,11 +2,21 @@ package services
 
 import (
 	"car-service/internal/domain/entities"
+	"car-service/internal/domain/pagination"
+	"car-service/internal/domain/repositories"
 	"context"
+
+	"github.com/google/uuid"
 )
 
 // CarService define las operaciones disponibles para los autos
 type CarService interface {
 	CreateCar(ctx context.Context, car *entities.Car) (*entities.Car, error)
-	GetCars(ctx context.Context) ([]*entities.Car, error)
+	GetCars(ctx context.Context, filter repositories.CarFilter) (*pagination.Page[*entities.Car], error)
+	GetCar(ctx context.Context, id uuid.UUID) (*entities.Car, error)
+	GetCarDetail(ctx context.Context, id uuid.UUID, relations repositories.CarRelations) (*entities.Car, error)
+	UpdateCar(ctx context.Context, car *entities.Car) (*entities.Car, error)
+	DeleteCar(ctx context.Context, id uuid.UUID) error
+	ChangeCarStatus(ctx context.Context, id uuid.UUID, status entities.CarStatus, reason string) (*entities.Car, error)
+	GetStatusHistory(ctx context.Context, id uuid.UUID) ([]*entities.CarStatusTransition, error)
 }
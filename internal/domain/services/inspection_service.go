@@ -0,0 +1,25 @@
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DueInspection es un auto cuya próxima inspección vence dentro del período consultado
+type DueInspection struct {
+	Car            *entities.Car
+	LastInspection *entities.Inspection // nil si el auto nunca fue inspeccionado
+	DueDate        time.Time
+}
+
+// InspectionService define las operaciones sobre las inspecciones técnicas periódicas
+type InspectionService interface {
+	CreateInspection(ctx context.Context, inspection *entities.Inspection) (*entities.Inspection, error)
+	GetCarInspections(ctx context.Context, carID uuid.UUID) ([]*entities.Inspection, error)
+	GetInspection(ctx context.Context, carID, id uuid.UUID) (*entities.Inspection, error)
+	// GetDueInspections retorna los autos cuya inspección vence hasta la fecha indicada, incluidas las vencidas
+	GetDueInspections(ctx context.Context, until time.Time) ([]*DueInspection, error)
+}
@@ -0,0 +1,26 @@
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InsuranceAlert es un auto sin seguro o cuya cobertura continua vence dentro del período consultado
+type InsuranceAlert struct {
+	Car          *entities.Car
+	Policy       *entities.InsurancePolicy // Póliza vigente; nil si el auto no está asegurado
+	CoveredUntil *time.Time                // Fin de la cobertura continua; nil si el auto no está asegurado
+}
+
+// InsurancePolicyService define las operaciones sobre las pólizas de seguro de los autos
+type InsurancePolicyService interface {
+	CreatePolicy(ctx context.Context, policy *entities.InsurancePolicy) (*entities.InsurancePolicy, error)
+	TerminatePolicy(ctx context.Context, carID, id uuid.UUID, date time.Time) (*entities.InsurancePolicy, error)
+	GetCarPolicies(ctx context.Context, carID uuid.UUID) ([]*entities.InsurancePolicy, error)
+	GetOwnerPolicies(ctx context.Context, ownerID uuid.UUID) ([]*entities.InsurancePolicy, error)
+	// GetInsuranceAlerts retorna los autos sin seguro en la fecha indicada y los que pierden la cobertura hasta until
+	GetInsuranceAlerts(ctx context.Context, at, until time.Time) ([]*InsuranceAlert, error)
+}
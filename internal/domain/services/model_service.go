@@ -0,0 +1,33 @@
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ModelFilter define los criterios opcionales para listar modelos
+type ModelFilter struct {
+	BrandID    uuid.UUID
+	Category   string
+	ActiveOnly bool
+	Specs      repositories.SpecFilter // Modelos con al menos una versión que cumple la ficha técnica
+}
+
+// ModelService define las operaciones disponibles para los modelos
+type ModelService interface {
+	CreateModel(ctx context.Context, model *entities.Model) (*entities.Model, error)
+	GetModels(ctx context.Context, filter ModelFilter) ([]*entities.Model, error)
+	GetModel(ctx context.Context, id uuid.UUID) (*entities.Model, error)
+	GetBrandModels(ctx context.Context, brandID uuid.UUID) ([]*entities.Model, error)
+	UpdateModel(ctx context.Context, model *entities.Model) (*entities.Model, error)
+	DeleteModel(ctx context.Context, id uuid.UUID) error
+	CreateTrim(ctx context.Context, trim *entities.ModelTrim) (*entities.ModelTrim, error)
+	GetModelTrims(ctx context.Context, modelID uuid.UUID) ([]*entities.ModelTrim, error)
+	GetModelTrim(ctx context.Context, modelID, id uuid.UUID) (*entities.ModelTrim, error)
+	UpdateTrim(ctx context.Context, trim *entities.ModelTrim) (*entities.ModelTrim, error)
+	// DeleteTrim rechaza la baja si hay autos asociados a la versión
+	DeleteTrim(ctx context.Context, modelID, id uuid.UUID) error
+}
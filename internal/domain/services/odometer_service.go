@@ -0,0 +1,23 @@
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// OdometerHistory contiene la serie de lecturas de un auto y su estado de anomalías
+type OdometerHistory struct {
+	Car      *entities.Car
+	Readings []*entities.OdometerReading
+}
+
+// OdometerService define las operaciones sobre el historial de kilometraje.
+// Las lecturas de servicios e inspecciones se sincronizan con RecordSourceReading y RemoveSourceReading.
+type OdometerService interface {
+	RecordReading(ctx context.Context, reading *entities.OdometerReading) (*entities.OdometerReading, error)
+	RecordSourceReading(ctx context.Context, reading *entities.OdometerReading) error
+	RemoveSourceReading(ctx context.Context, carID uuid.UUID, source entities.OdometerSource, sourceID uuid.UUID) error
+	GetOdometerHistory(ctx context.Context, carID uuid.UUID) (*OdometerHistory, error)
+}
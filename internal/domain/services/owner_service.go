@@ -0,0 +1,18 @@
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// OwnerService define las operaciones disponibles para los propietarios
+type OwnerService interface {
+	CreateOwner(ctx context.Context, owner *entities.Owner) (*entities.Owner, error)
+	GetOwners(ctx context.Context) ([]*entities.Owner, error)
+	GetOwner(ctx context.Context, id uuid.UUID) (*entities.Owner, error)
+	UpdateOwner(ctx context.Context, owner *entities.Owner) (*entities.Owner, error)
+	DeleteOwner(ctx context.Context, id uuid.UUID) error
+	GetOwnerCars(ctx context.Context, id uuid.UUID) ([]*entities.Car, error)
+}
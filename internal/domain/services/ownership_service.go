@@ -0,0 +1,24 @@
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OwnershipTransfer contiene los datos de una transferencia de propiedad
+type OwnershipTransfer struct {
+	CarID   uuid.UUID
+	OwnerID uuid.UUID
+	Date    time.Time
+	Price   *float64
+	Notes   string
+}
+
+// OwnershipService define las operaciones sobre la titularidad de los autos
+type OwnershipService interface {
+	TransferOwnership(ctx context.Context, transfer OwnershipTransfer) (*entities.OwnershipRecord, error)
+	GetOwnershipHistory(ctx context.Context, carID uuid.UUID) ([]*entities.OwnershipRecord, error)
+}
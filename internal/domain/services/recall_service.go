@@ -0,0 +1,36 @@
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecallDetail contiene una campaña con el avance de las reparaciones de los vehículos alcanzados
+type RecallDetail struct {
+	Recall   *entities.Recall
+	Affected int
+	Remedied int
+}
+
+// RecallRemedy contiene los datos de la reparación de un vehículo alcanzado por una campaña
+type RecallRemedy struct {
+	CarID    uuid.UUID
+	RecallID uuid.UUID
+	Date     time.Time
+	Workshop string
+	Notes    string
+}
+
+// RecallService define las operaciones sobre las campañas de llamado a revisión
+type RecallService interface {
+	// CreateRecall registra la campaña y la vincula con los autos existentes que alcanza
+	CreateRecall(ctx context.Context, recall *entities.Recall) (*RecallDetail, error)
+	GetRecall(ctx context.Context, id uuid.UUID) (*RecallDetail, error)
+	// GetAffectedCars y GetCarRecalls filtran por el estado de la reparación; un estado vacío no filtra
+	GetAffectedCars(ctx context.Context, recallID uuid.UUID, status entities.RecallRemedyStatus) ([]*entities.CarRecall, error)
+	GetCarRecalls(ctx context.Context, carID uuid.UUID, status entities.RecallRemedyStatus) ([]*entities.CarRecall, error)
+	CompleteRemedy(ctx context.Context, remedy RecallRemedy) (*entities.CarRecall, error)
+}
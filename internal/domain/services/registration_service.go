@@ -0,0 +1,16 @@
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RegistrationService define las operaciones sobre las patentes de los autos
+type RegistrationService interface {
+	RegisterPlate(ctx context.Context, registration *entities.Registration) (*entities.Registration, error)
+	CancelRegistration(ctx context.Context, carID, id uuid.UUID, date time.Time) (*entities.Registration, error)
+	GetCarRegistrations(ctx context.Context, carID uuid.UUID) ([]*entities.Registration, error)
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ServiceRecordService define las operaciones sobre el historial de servicios y mantenimiento
+type ServiceRecordService interface {
+	CreateServiceRecord(ctx context.Context, record *entities.ServiceRecord) (*entities.ServiceRecord, error)
+	GetServiceRecords(ctx context.Context, carID uuid.UUID) ([]*entities.ServiceRecord, error)
+	GetServiceRecord(ctx context.Context, carID, id uuid.UUID) (*entities.ServiceRecord, error)
+	UpdateServiceRecord(ctx context.Context, record *entities.ServiceRecord) (*entities.ServiceRecord, error)
+	DeleteServiceRecord(ctx context.Context, carID, id uuid.UUID) error
+}
@@ -0,0 +1,19 @@
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StolenReportService define las operaciones sobre denuncias de robo y la lista de vigilancia de VIN
+type StolenReportService interface {
+	ReportStolen(ctx context.Context, report *entities.StolenReport) (*entities.StolenReport, error)
+	RecoverStolen(ctx context.Context, id uuid.UUID, date time.Time) (*entities.StolenReport, error)
+	GetStolenReport(ctx context.Context, id uuid.UUID) (*entities.StolenReport, error)
+	GetCarStolenReports(ctx context.Context, carID uuid.UUID) ([]*entities.StolenReport, error)
+	// CheckVIN retorna la denuncia vigente del VIN o nil si no está en la lista de vigilancia
+	CheckVIN(ctx context.Context, vin string) (*entities.StolenReport, error)
+}
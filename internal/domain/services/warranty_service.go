@@ -0,0 +1,31 @@
+package services
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/warranty"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CarWarranty contiene el estado de la garantía de fábrica de un auto
+type CarWarranty struct {
+	Car             *entities.Car
+	Template        *entities.WarrantyTemplate // Nulo si ni el modelo ni la marca tienen plantilla
+	StartDate       time.Time
+	FirstRegistered bool                      // Falso si el auto nunca fue patentado y la garantía se cuenta desde su alta
+	Odometer        *entities.OdometerReading // Última lectura consistente; nula si no hay lecturas
+	Coverages       []warranty.Status
+}
+
+// WarrantyService define las operaciones sobre las plantillas de garantía y la cobertura de cada auto
+type WarrantyService interface {
+	CreateTemplate(ctx context.Context, template *entities.WarrantyTemplate) (*entities.WarrantyTemplate, error)
+	GetTemplate(ctx context.Context, id uuid.UUID) (*entities.WarrantyTemplate, error)
+	GetTemplates(ctx context.Context) ([]*entities.WarrantyTemplate, error)
+	DeleteTemplate(ctx context.Context, id uuid.UUID) error
+	GetCarWarranty(ctx context.Context, carID uuid.UUID, at time.Time) (*CarWarranty, error)
+	// CoveringService retorna la cobertura que alcanza al trabajo del servicio, o nil si no está cubierto
+	CoveringService(ctx context.Context, record *entities.ServiceRecord) (*entities.WarrantyCoverage, error)
+}
@@ -0,0 +1,200 @@
+// Package vin valida y decodifica números de identificación vehicular (ISO 3779)
+package vin
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Length es la cantidad de caracteres de un VIN
+const Length = 17
+
+var (
+	ErrInvalidLength     = fmt.Errorf("el VIN debe tener %d caracteres", Length)
+	ErrInvalidCharacter  = errors.New("el VIN contiene caracteres no permitidos (I, O y Q no son válidos)")
+	ErrInvalidCheckDigit = errors.New("el dígito verificador del VIN (posición 9) no es válido")
+	ErrInvalidModelYear  = errors.New("el código de año de modelo del VIN (posición 10) no es válido")
+)
+
+// transliteration asigna a cada carácter permitido su valor numérico para el cálculo del dígito verificador
+var transliteration = map[rune]int{
+	'0': 0, '1': 1, '2': 2, '3': 3, '4': 4, '5': 5, '6': 6, '7': 7, '8': 8, '9': 9,
+	'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+	'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+	'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+}
+
+// weights son los pesos de cada posición; la posición 9 (dígito verificador) pesa 0
+var weights = [Length]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// CheckDigitScope indica a qué VIN se les exige el dígito verificador de la posición 9
+type CheckDigitScope string
+
+const (
+	// CheckDigitScopeAll exige el dígito verificador a todos los VIN (valor por defecto)
+	CheckDigitScopeAll CheckDigitScope = "all"
+	// CheckDigitScopeNorthAmerica lo exige solo a los VIN norteamericanos (WMI que comienza con 1-5);
+	// en otras regiones la posición 9 queda como un carácter libre del fabricante
+	CheckDigitScopeNorthAmerica CheckDigitScope = "north-america"
+)
+
+// checkDigitScope es el alcance vigente del dígito verificador
+var checkDigitScope = CheckDigitScopeAll
+
+// SetCheckDigitScope configura a qué VIN se les exige el dígito verificador
+func SetCheckDigitScope(scope CheckDigitScope) error {
+	switch scope {
+	case CheckDigitScopeAll, CheckDigitScopeNorthAmerica:
+		checkDigitScope = scope
+		return nil
+	default:
+		return fmt.Errorf("alcance del dígito verificador no soportado: %q", scope)
+	}
+}
+
+// modelYearCodes es el ciclo de 30 códigos de año de modelo a partir de 1980
+const modelYearCodes = "ABCDEFGHJKLMNPRSTVWXY123456789"
+
+// Decoded contiene las secciones de un VIN válido
+type Decoded struct {
+	VIN           string `json:"vin"`
+	WMI           string `json:"wmi"`           // World Manufacturer Identifier (posiciones 1-3)
+	Region        string `json:"region"`        // Región geográfica derivada del primer carácter
+	VDS           string `json:"vds"`           // Vehicle Descriptor Section (posiciones 4-8)
+	CheckDigit    string `json:"checkDigit"`    // Dígito verificador (posición 9)
+	CheckVerified bool   `json:"checkVerified"` // Indica si se verificó el dígito verificador; con el alcance north-america es false fuera de Norteamérica
+	VIS           string `json:"vis"`           // Vehicle Identifier Section (posiciones 10-17)
+	ModelYearCode string `json:"modelYearCode"` // Código de año de modelo (posición 10)
+	ModelYears    []int  `json:"modelYears"`    // Años posibles para el código, del más reciente al más antiguo
+	ModelYear     int    `json:"modelYear"`     // Año más reciente que no supera el año siguiente al actual
+	PlantCode     string `json:"plantCode"`     // Código de planta de ensamblaje (posición 11)
+	SerialNumber  string `json:"serialNumber"`  // Número de serie (posiciones 12-17)
+}
+
+// Normalize elimina espacios y convierte el VIN a mayúsculas
+func Normalize(vin string) string {
+	return strings.ToUpper(strings.TrimSpace(vin))
+}
+
+// Validate verifica longitud, caracteres permitidos, código de año de modelo y el dígito
+// verificador según el alcance configurado (por defecto, en todos los VIN)
+func Validate(vin string) error {
+	vin = Normalize(vin)
+	if len(vin) != Length {
+		return ErrInvalidLength
+	}
+
+	for _, char := range vin {
+		if _, ok := transliteration[char]; !ok {
+			return ErrInvalidCharacter
+		}
+	}
+
+	if RequiresCheckDigit(vin) && CheckDigit(vin) != vin[8] {
+		return ErrInvalidCheckDigit
+	}
+
+	if strings.IndexByte(modelYearCodes, vin[9]) < 0 {
+		return ErrInvalidModelYear
+	}
+	return nil
+}
+
+// RequiresCheckDigit indica si se exige el dígito verificador al VIN según el alcance configurado
+func RequiresCheckDigit(vin string) bool {
+	if checkDigitScope == CheckDigitScopeAll {
+		return true
+	}
+	vin = Normalize(vin)
+	return len(vin) > 0 && Region(vin[0]) == "North America"
+}
+
+// CheckDigit calcula el dígito verificador esperado para un VIN de 17 caracteres permitidos
+func CheckDigit(vin string) byte {
+	sum := 0
+	for i, char := range vin {
+		sum += transliteration[char] * weights[i]
+	}
+	remainder := sum % 11
+	if remainder == 10 {
+		return 'X'
+	}
+	return byte('0' + remainder)
+}
+
+// Decode valida el VIN y retorna sus secciones
+func Decode(vin string) (*Decoded, error) {
+	vin = Normalize(vin)
+	if err := Validate(vin); err != nil {
+		return nil, err
+	}
+
+	modelYears := ModelYears(vin[9])
+	return &Decoded{
+		VIN:           vin,
+		WMI:           vin[0:3],
+		Region:        Region(vin[0]),
+		VDS:           vin[3:8],
+		CheckDigit:    vin[8:9],
+		CheckVerified: RequiresCheckDigit(vin),
+		VIS:           vin[9:17],
+		ModelYearCode: vin[9:10],
+		ModelYears:    modelYears,
+		ModelYear:     latestModelYear(modelYears),
+		PlantCode:     vin[10:11],
+		SerialNumber:  vin[11:17],
+	}, nil
+}
+
+// WMI retorna el identificador mundial del fabricante (posiciones 1-3)
+func WMI(vin string) string {
+	vin = Normalize(vin)
+	if len(vin) < 3 {
+		return ""
+	}
+	return vin[0:3]
+}
+
+// ModelYears retorna los años que corresponden a un código de año de modelo, del más reciente al más antiguo.
+// El código se repite cada 30 años a partir de 1980.
+func ModelYears(code byte) []int {
+	index := strings.IndexByte(modelYearCodes, code)
+	if index < 0 {
+		return nil
+	}
+
+	var years []int
+	for year := 1980 + index; year <= time.Now().Year()+1; year += len(modelYearCodes) {
+		years = append([]int{year}, years...)
+	}
+	return years
+}
+
+// Region retorna la región geográfica asignada al primer carácter del VIN
+func Region(first byte) string {
+	switch {
+	case first >= 'A' && first <= 'H':
+		return "Africa"
+	case first >= 'J' && first <= 'R':
+		return "Asia"
+	case first >= 'S' && first <= 'Z':
+		return "Europe"
+	case first >= '1' && first <= '5':
+		return "North America"
+	case first == '6' || first == '7':
+		return "Oceania"
+	case first == '8' || first == '9' || first == '0':
+		return "South America"
+	default:
+		return "Unknown"
+	}
+}
+
+func latestModelYear(years []int) int {
+	if len(years) == 0 {
+		return 0
+	}
+	return years[0]
+}
@@ -0,0 +1,105 @@
+package vin
+
+import (
+	"errors"
+	"testing"
+)
+
+// useCheckDigitScope configura el alcance del dígito verificador durante el test
+func useCheckDigitScope(t *testing.T, scope CheckDigitScope) {
+	t.Helper()
+	previous := checkDigitScope
+	if err := SetCheckDigitScope(scope); err != nil {
+		t.Fatalf("SetCheckDigitScope(%q): %v", scope, err)
+	}
+	t.Cleanup(func() {
+		checkDigitScope = previous
+	})
+}
+
+func TestValidateCheckDigitByScope(t *testing.T) {
+	tests := []struct {
+		name   string
+		vin    string
+		allErr error
+		naErr  error
+	}{
+		{name: "norteamericano válido", vin: "1HGCM82633A004352"},
+		{name: "norteamericano en minúsculas", vin: " 1hgcm82633a004352 "},
+		{name: "norteamericano con dígito inválido", vin: "1HGCM82643A004352", allErr: ErrInvalidCheckDigit, naErr: ErrInvalidCheckDigit},
+		{name: "europeo válido", vin: "WVWZZZ1J93W386752"},
+		{name: "sudamericano 8AJ con dígito inválido", vin: "8AJFZ29G0K6012345", allErr: ErrInvalidCheckDigit},
+		{name: "sudamericano 9BR con dígito inválido", vin: "9BRBL3HE5K0123456", allErr: ErrInvalidCheckDigit},
+		{name: "europeo con dígito inválido", vin: "WVWZZZ1JZ3W386752", allErr: ErrInvalidCheckDigit},
+		{name: "sudamericano con caracteres no permitidos", vin: "9BRBL3HE5K012345O", allErr: ErrInvalidCharacter, naErr: ErrInvalidCharacter},
+		{name: "longitud inválida", vin: "9BRBL3HE5K012345", allErr: ErrInvalidLength, naErr: ErrInvalidLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCheckDigitScope(t, CheckDigitScopeAll)
+			if err := Validate(tt.vin); !errors.Is(err, tt.allErr) {
+				t.Errorf("alcance all: Validate(%q) = %v, se esperaba %v", tt.vin, err, tt.allErr)
+			}
+
+			useCheckDigitScope(t, CheckDigitScopeNorthAmerica)
+			if err := Validate(tt.vin); !errors.Is(err, tt.naErr) {
+				t.Errorf("alcance north-america: Validate(%q) = %v, se esperaba %v", tt.vin, err, tt.naErr)
+			}
+		})
+	}
+}
+
+func TestValidateChecksDigitForEveryRegionByDefault(t *testing.T) {
+	if checkDigitScope != CheckDigitScopeAll {
+		t.Fatalf("alcance por defecto = %q, se esperaba %q", checkDigitScope, CheckDigitScopeAll)
+	}
+	for _, vin := range []string{"8AJFZ29G0K6012345", "9BRBL3HE5K0123456", "WVWZZZ1JZ3W386752"} {
+		if CheckDigit(vin) == vin[8] {
+			t.Fatalf("%s tiene un dígito verificador válido; el caso no prueba la verificación", vin)
+		}
+		if !RequiresCheckDigit(vin) {
+			t.Errorf("RequiresCheckDigit(%q) = false, se esperaba true", vin)
+		}
+	}
+}
+
+func TestValidateRejectsInvalidModelYear(t *testing.T) {
+	vin := "1HGCM8261UA004352"
+	if err := Validate(vin); !errors.Is(err, ErrInvalidModelYear) {
+		t.Errorf("Validate(%q) = %v, se esperaba %v", vin, err, ErrInvalidModelYear)
+	}
+}
+
+func TestSetCheckDigitScopeRejectsUnknownScope(t *testing.T) {
+	useCheckDigitScope(t, CheckDigitScopeAll)
+	if err := SetCheckDigitScope("europe"); err == nil {
+		t.Fatal("se esperaba un error para un alcance desconocido")
+	}
+	if checkDigitScope != CheckDigitScopeAll {
+		t.Errorf("el alcance cambió a %q", checkDigitScope)
+	}
+}
+
+func TestDecodeReportsCheckVerification(t *testing.T) {
+	tests := []struct {
+		scope    CheckDigitScope
+		vin      string
+		region   string
+		verified bool
+	}{
+		{scope: CheckDigitScopeAll, vin: "1HGCM82633A004352", region: "North America", verified: true},
+		{scope: CheckDigitScopeNorthAmerica, vin: "1HGCM82633A004352", region: "North America", verified: true},
+		{scope: CheckDigitScopeNorthAmerica, vin: "9BRBL3HE5K0123456", region: "South America", verified: false},
+	}
+	for _, tt := range tests {
+		useCheckDigitScope(t, tt.scope)
+		decoded, err := Decode(tt.vin)
+		if err != nil {
+			t.Fatalf("%s: Decode(%q): error inesperado: %v", tt.scope, tt.vin, err)
+		}
+		if decoded.Region != tt.region || decoded.CheckVerified != tt.verified {
+			t.Errorf("%s: Decode(%q): región=%s verificado=%v, se esperaba %s/%v",
+				tt.scope, tt.vin, decoded.Region, decoded.CheckVerified, tt.region, tt.verified)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Package warranty calcula la cobertura de garantía de un vehículo a partir de su plantilla
+package warranty
+
+import (
+	"car-service/internal/domain/entities"
+	"math"
+	"time"
+)
+
+// ExpiredBy indica qué límite venció una cobertura
+type ExpiredBy string
+
+const (
+	ExpiredByNone     ExpiredBy = ""
+	ExpiredByTime     ExpiredBy = "time"
+	ExpiredByOdometer ExpiredBy = "odometer"
+)
+
+// claimableServiceTypes son los tipos de servicio que pueden cubrirse por garantía; el
+// mantenimiento programado y los neumáticos son desgaste y quedan a cargo del propietario
+var claimableServiceTypes = map[entities.ServiceType]bool{
+	entities.ServiceTypeRepair:   true,
+	entities.ServiceTypeBodywork: true,
+	entities.ServiceTypeOther:    true,
+}
+
+// Status es el estado de una cobertura en una fecha y con un kilometraje dados
+type Status struct {
+	Coverage          entities.WarrantyCoverage
+	ExpiresAt         time.Time
+	Active            bool
+	ExpiredBy         ExpiredBy
+	RemainingDays     int
+	RemainingDistance *int // Nulo si la cobertura no tiene límite de kilometraje
+}
+
+// Evaluate calcula el estado de la cobertura desde la fecha de inicio de la garantía
+func Evaluate(coverage entities.WarrantyCoverage, start time.Time, odometer int, at time.Time) Status {
+	status := Status{
+		Coverage:  coverage,
+		ExpiresAt: start.AddDate(0, coverage.Months, 0),
+	}
+
+	if at.Before(status.ExpiresAt) {
+		status.RemainingDays = int(math.Ceil(status.ExpiresAt.Sub(at).Hours() / 24))
+	} else {
+		status.ExpiredBy = ExpiredByTime
+	}
+
+	if coverage.OdometerLimit > 0 {
+		remaining := max(coverage.OdometerLimit-odometer, 0)
+		status.RemainingDistance = &remaining
+		if remaining == 0 && status.ExpiredBy == ExpiredByNone {
+			status.ExpiredBy = ExpiredByOdometer
+		}
+	}
+
+	status.Active = status.ExpiredBy == ExpiredByNone
+	if !status.Active {
+		status.RemainingDays = 0
+	}
+	return status
+}
+
+// EvaluateAll calcula el estado de todas las coberturas de la plantilla
+func EvaluateAll(template *entities.WarrantyTemplate, start time.Time, odometer int, at time.Time) []Status {
+	statuses := make([]Status, len(template.Coverages))
+	for i, coverage := range template.Coverages {
+		statuses[i] = Evaluate(coverage, start, odometer, at)
+	}
+	return statuses
+}
+
+// CoveringService retorna la cobertura que cubre un trabajo del tipo y grupo indicados realizado en
+// la fecha y con el kilometraje dados, o nil si no está cubierto. Se prefiere la cobertura específica
+// del grupo y, si no está vigente, la integral. Un grupo vacío solo puede cubrirse por la integral.
+func CoveringService(template *entities.WarrantyTemplate, start time.Time, serviceType entities.ServiceType, group entities.ComponentGroup, date time.Time, odometer int) *entities.WarrantyCoverage {
+	if template == nil || !claimableServiceTypes[serviceType] {
+		return nil
+	}
+
+	var general *entities.WarrantyCoverage
+	for i := range template.Coverages {
+		coverage := &template.Coverages[i]
+		if !Evaluate(*coverage, start, odometer, date).Active {
+			continue
+		}
+		if group != "" && coverage.ComponentGroup == group {
+			return coverage
+		}
+		if coverage.ComponentGroup == entities.ComponentGroupGeneral {
+			general = coverage
+		}
+	}
+	return general
+}
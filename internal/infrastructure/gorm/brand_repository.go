@@ -0,0 +1,71 @@
+package gorm
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// BrandRepository implementa la interfaz repositories.BrandRepository usando GORM
+type BrandRepository struct {
+	db *gorm.DB
+}
+
+// NewBrandRepository crea una nueva instancia de BrandRepository
+func NewBrandRepository(db *gorm.DB) repositories.BrandRepository {
+	return &BrandRepository{
+		db: db,
+	}
+}
+
+// Create guarda una nueva marca en la base de datos
+func (r *BrandRepository) Create(ctx context.Context, brand *entities.Brand) error {
+	return conn(ctx, r.db).Create(brand).Error
+}
+
+// GetByID obtiene una marca por su ID
+func (r *BrandRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Brand, error) {
+	var brand entities.Brand
+	err := conn(ctx, r.db).First(&brand, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &brand, nil
+}
+
+// GetByName obtiene una marca por su nombre, sin distinguir mayúsculas de minúsculas
+func (r *BrandRepository) GetByName(ctx context.Context, name string) (*entities.Brand, error) {
+	var brand entities.Brand
+	err := conn(ctx, r.db).Where("LOWER(name) = LOWER(?)", name).First(&brand).Error
+	if err != nil {
+		return nil, err
+	}
+	return &brand, nil
+}
+
+// Update actualiza una marca existente
+func (r *BrandRepository) Update(ctx context.Context, brand *entities.Brand) error {
+	return conn(ctx, r.db).Save(brand).Error
+}
+
+// Delete elimina una marca por su ID
+func (r *BrandRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&entities.Brand{}, "id = ?", id).Error
+}
+
+// List obtiene todas las marcas
+func (r *BrandRepository) List(ctx context.Context) ([]*entities.Brand, error) {
+	var brands []*entities.Brand
+	err := conn(ctx, r.db).Order("name").Find(&brands).Error
+	return brands, err
+}
+
+// ListActive obtiene todas las marcas activas
+func (r *BrandRepository) ListActive(ctx context.Context) ([]*entities.Brand, error) {
+	var brands []*entities.Brand
+	err := conn(ctx, r.db).Where("active = ?", true).Order("name").Find(&brands).Error
+	return brands, err
+}
@@ -2,6 +2,7 @@ package gorm
 
 import (
 	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
 	"context"
 
 	"github.com/google/uuid"
@@ -22,13 +23,23 @@ func NewCarRepository(db *gorm.DB) *CarRepository {
 
 // Create guarda un nuevo auto en la base de datos
 func (r *CarRepository) Create(ctx context.Context, car *entities.Car) (*entities.Car, error) {
-	return car, r.db.WithContext(ctx).Create(car).Error
+	return car, conn(ctx, r.db).Create(car).Error
 }
 
 // GetByID obtiene un auto por su ID
-func (r *CarRepository) GetByID(id uuid.UUID) (*entities.Car, error) {
+func (r *CarRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Car, error) {
 	var car entities.Car
-	err := r.db.First(&car, "id = ?", id).Error
+	err := conn(ctx, r.db).First(&car, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &car, nil
+}
+
+// GetByIDWithRelations obtiene un auto por su ID cargando las relaciones indicadas
+func (r *CarRepository) GetByIDWithRelations(ctx context.Context, id uuid.UUID, relations repositories.CarRelations) (*entities.Car, error) {
+	var car entities.Car
+	err := withRelations(conn(ctx, r.db), relations).First(&car, "cars.id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -36,33 +47,148 @@ func (r *CarRepository) GetByID(id uuid.UUID) (*entities.Car, error) {
 }
 
 // Update actualiza un auto existente
-func (r *CarRepository) Update(car *entities.Car) error {
-	return r.db.Save(car).Error
+func (r *CarRepository) Update(ctx context.Context, car *entities.Car) error {
+	return conn(ctx, r.db).Save(car).Error
 }
 
 // Delete elimina un auto por su ID
-func (r *CarRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&entities.Car{}, "id = ?", id).Error
+func (r *CarRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&entities.Car{}, "id = ?", id).Error
 }
 
 // GetByOwnerID obtiene todos los autos de un propietario
-func (r *CarRepository) GetByOwnerID(ownerID uuid.UUID) ([]*entities.Car, error) {
+func (r *CarRepository) GetByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*entities.Car, error) {
 	var cars []*entities.Car
-	err := r.db.Where("owner_id = ?", ownerID).Find(&cars).Error
+	err := conn(ctx, r.db).Where("owner_id = ?", ownerID).Find(&cars).Error
+	return cars, err
+}
+
+// CountByModelID cuenta los autos de un modelo
+func (r *CarRepository) CountByModelID(ctx context.Context, modelID uuid.UUID) (int64, error) {
+	var count int64
+	err := conn(ctx, r.db).Model(&entities.Car{}).Where("model_id = ?", modelID).Count(&count).Error
+	return count, err
+}
+
+// CountByTrimID cuenta los autos de una versión
+func (r *CarRepository) CountByTrimID(ctx context.Context, trimID uuid.UUID) (int64, error) {
+	var count int64
+	err := conn(ctx, r.db).Model(&entities.Car{}).Where("trim_id = ?", trimID).Count(&count).Error
+	return count, err
+}
+
+// ListByModelYears obtiene los autos de un modelo dentro del rango de años indicado
+func (r *CarRepository) ListByModelYears(ctx context.Context, modelID uuid.UUID, yearFrom, yearTo int) ([]*entities.Car, error) {
+	query := conn(ctx, r.db).Where("model_id = ?", modelID)
+	if yearFrom != 0 {
+		query = query.Where("year >= ?", yearFrom)
+	}
+	if yearTo != 0 {
+		query = query.Where("year <= ?", yearTo)
+	}
+
+	var cars []*entities.Car
+	err := query.Find(&cars).Error
 	return cars, err
 }
 
 // List obtiene todos los autos
-func (r *CarRepository) List() ([]*entities.Car, error) {
+func (r *CarRepository) List(ctx context.Context) ([]*entities.Car, error) {
 	var cars []*entities.Car
-	err := r.db.Find(&cars).Error
+	err := conn(ctx, r.db).Find(&cars).Error
 	return cars, err
 }
 
+// carSortColumns asocia los campos de ordenamiento con sus columnas
+var carSortColumns = map[string]string{
+	"createdAt": "cars.created_at",
+	"updatedAt": "cars.updated_at",
+	"year":      "cars.year",
+	"color":     "cars.color",
+	"vin":       "cars.vin",
+}
+
+// Search obtiene una página de autos que cumplen el filtro junto con el total de coincidencias
+func (r *CarRepository) Search(ctx context.Context, filter repositories.CarFilter) ([]*entities.Car, int64, error) {
+	query := conn(ctx, r.db).Model(&entities.Car{})
+	if filter.BrandID != uuid.Nil {
+		query = query.Joins("JOIN models ON models.id = cars.model_id").Where("models.brand_id = ?", filter.BrandID)
+	}
+	if filter.ModelID != uuid.Nil {
+		query = query.Where("cars.model_id = ?", filter.ModelID)
+	}
+	if filter.OwnerID != uuid.Nil {
+		query = query.Where("cars.owner_id = ?", filter.OwnerID)
+	}
+	if filter.YearFrom != 0 {
+		query = query.Where("cars.year >= ?", filter.YearFrom)
+	}
+	if filter.YearTo != 0 {
+		query = query.Where("cars.year <= ?", filter.YearTo)
+	}
+	if filter.Color != "" {
+		query = query.Where("LOWER(cars.color) = LOWER(?)", filter.Color)
+	}
+	if filter.Plate != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM registrations WHERE registrations.car_id = cars.id AND registrations.plate = ? AND registrations.cancelled_at IS NULL AND registrations.deleted_at IS NULL)", filter.Plate)
+	}
+	if filter.Status != "" {
+		query = query.Where("cars.status = ?", filter.Status)
+	}
+	if filter.Active != nil {
+		if *filter.Active {
+			query = query.Where("cars.status NOT IN ?", entities.FinalCarStatuses())
+		} else {
+			query = query.Where("cars.status IN ?", entities.FinalCarStatuses())
+		}
+	}
+	if !filter.Specs.IsEmpty() {
+		query = query.Where("EXISTS (?)", trimSpecQuery(conn(ctx, r.db), filter.Specs).Where("model_trims.id = cars.trim_id"))
+	}
+
+	// La sesión permite reutilizar las condiciones para el conteo y la consulta de la página
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	column, ok := carSortColumns[filter.SortField]
+	if !ok {
+		column = carSortColumns["createdAt"]
+	}
+	order := column + " ASC"
+	if filter.SortDesc {
+		order = column + " DESC"
+	}
+
+	page := filter.Page.Normalize()
+	var cars []*entities.Car
+	err := withRelations(query, filter.Relations).Order(order).Order("cars.id").Offset(page.Offset()).Limit(page.PageSize).Find(&cars).Error
+	return cars, total, err
+}
+
+// withRelations agrega los Preload necesarios para las relaciones solicitadas
+func withRelations(query *gorm.DB, relations repositories.CarRelations) *gorm.DB {
+	if relations.Brand {
+		query = query.Preload("Model.Brand")
+	} else if relations.Model {
+		query = query.Preload("Model")
+	}
+	if relations.Owner {
+		query = query.Preload("Owner")
+	}
+	if relations.Trim {
+		query = query.Preload("Trim")
+	}
+	return query
+}
+
 // GetByVIN obtiene un auto por su número de VIN
-func (r *CarRepository) GetByVIN(vin string) (*entities.Car, error) {
+func (r *CarRepository) GetByVIN(ctx context.Context, vin string) (*entities.Car, error) {
 	var car entities.Car
-	err := r.db.Where("vin = ?", vin).First(&car).Error
+	err := conn(ctx, r.db).Where("vin = ?", vin).First(&car).Error
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,37 @@
+package gorm
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CarStatusTransitionRepository implementa la interfaz repositories.CarStatusTransitionRepository usando GORM
+type CarStatusTransitionRepository struct {
+	db *gorm.DB
+}
+
+// NewCarStatusTransitionRepository crea una nueva instancia de CarStatusTransitionRepository
+func NewCarStatusTransitionRepository(db *gorm.DB) repositories.CarStatusTransitionRepository {
+	return &CarStatusTransitionRepository{
+		db: db,
+	}
+}
+
+// Create guarda una nueva transición de estado
+func (r *CarStatusTransitionRepository) Create(ctx context.Context, transition *entities.CarStatusTransition) error {
+	return conn(ctx, r.db).Create(transition).Error
+}
+
+// ListByCarID obtiene las transiciones de estado de un auto, de la más reciente a la más antigua
+func (r *CarStatusTransitionRepository) ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.CarStatusTransition, error) {
+	var transitions []*entities.CarStatusTransition
+	err := conn(ctx, r.db).
+		Where("car_id = ?", carID).
+		Order("created_at DESC").
+		Find(&transitions).Error
+	return transitions, err
+}
@@ -0,0 +1,88 @@
+package gorm
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// InspectionRepository implementa la interfaz repositories.InspectionRepository usando GORM
+type InspectionRepository struct {
+	db *gorm.DB
+}
+
+// NewInspectionRepository crea una nueva instancia de InspectionRepository
+func NewInspectionRepository(db *gorm.DB) repositories.InspectionRepository {
+	return &InspectionRepository{
+		db: db,
+	}
+}
+
+// Create guarda una nueva inspección junto con sus defectos
+func (r *InspectionRepository) Create(ctx context.Context, inspection *entities.Inspection) error {
+	return conn(ctx, r.db).Create(inspection).Error
+}
+
+// GetByID obtiene una inspección de un auto con sus defectos
+func (r *InspectionRepository) GetByID(ctx context.Context, carID, id uuid.UUID) (*entities.Inspection, error) {
+	var inspection entities.Inspection
+	err := conn(ctx, r.db).
+		Preload("Defects").
+		First(&inspection, "id = ? AND car_id = ?", id, carID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &inspection, nil
+}
+
+// ListByCarID obtiene las inspecciones de un auto, de la más reciente a la más antigua
+func (r *InspectionRepository) ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.Inspection, error) {
+	var inspections []*entities.Inspection
+	err := conn(ctx, r.db).
+		Preload("Defects").
+		Where("car_id = ?", carID).
+		Order("date DESC, created_at DESC").
+		Find(&inspections).Error
+	return inspections, err
+}
+
+// ListLatestDue obtiene la última inspección de cada auto que vence hasta la fecha indicada
+func (r *InspectionRepository) ListLatestDue(ctx context.Context, until time.Time, excluded []entities.CarStatus) ([]*entities.Inspection, error) {
+	db := conn(ctx, r.db)
+	latest := db.Model(&entities.Inspection{}).
+		Select("DISTINCT ON (car_id) *").
+		Order("car_id, date DESC, created_at DESC")
+
+	query := db.Table("(?) AS inspections", latest).
+		Joins("JOIN cars ON cars.id = inspections.car_id AND cars.deleted_at IS NULL").
+		Where("inspections.next_due_date <= ?", until)
+	if len(excluded) > 0 {
+		query = query.Where("cars.status NOT IN ?", excluded)
+	}
+
+	var inspections []*entities.Inspection
+	err := query.
+		Select("inspections.*").
+		Preload("Car.Model").
+		Order("inspections.next_due_date").
+		Find(&inspections).Error
+	return inspections, err
+}
+
+// ListUninspectedCars obtiene los autos que no tienen inspecciones registradas
+func (r *InspectionRepository) ListUninspectedCars(ctx context.Context, excluded []entities.CarStatus) ([]*entities.Car, error) {
+	query := conn(ctx, r.db).
+		Preload("Model").
+		Where("NOT EXISTS (SELECT 1 FROM inspections WHERE inspections.car_id = cars.id AND inspections.deleted_at IS NULL)")
+	if len(excluded) > 0 {
+		query = query.Where("status NOT IN ?", excluded)
+	}
+
+	var cars []*entities.Car
+	err := query.Find(&cars).Error
+	return cars, err
+}
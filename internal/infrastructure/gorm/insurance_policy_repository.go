@@ -0,0 +1,185 @@
+package gorm
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// effectiveEndColumn es la fecha en que termina la cobertura de una póliza: la baja anticipada o el vencimiento.
+// LEAST ignora los valores nulos en PostgreSQL, por lo que sin baja retorna end_date.
+const effectiveEndColumn = "LEAST(terminated_at, end_date)"
+
+// effectiveEndOf retorna la expresión de effectiveEndColumn para la tabla o alias indicado
+func effectiveEndOf(table string) string {
+	return fmt.Sprintf("LEAST(%s.terminated_at, %s.end_date)", table, table)
+}
+
+// coverageAlertsQuery selecciona los autos sin póliza vigente en @at o cuya cobertura continua termina hasta @until.
+// La cobertura continua termina en el primer fin de póliza posterior a @at que ninguna otra póliza del auto cubre:
+// una renovación que comienza antes o en ese momento extiende la cobertura.
+var coverageAlertsQuery = fmt.Sprintf(`
+SELECT cars.*, %%s, coverage.covered_until
+FROM cars
+LEFT JOIN LATERAL (
+	SELECT * FROM insurance_policies policy
+	WHERE policy.car_id = cars.id AND policy.deleted_at IS NULL
+		AND policy.start_date <= @at AND %[1]s > @at
+	ORDER BY policy.start_date
+	LIMIT 1
+) active_policy ON true
+LEFT JOIN LATERAL (
+	SELECT MIN(%[1]s) AS covered_until
+	FROM insurance_policies policy
+	WHERE policy.car_id = cars.id AND policy.deleted_at IS NULL
+		AND %[1]s > @at AND %[1]s > policy.start_date
+		AND NOT EXISTS (
+			SELECT 1 FROM insurance_policies renewal
+			WHERE renewal.car_id = policy.car_id AND renewal.deleted_at IS NULL
+				AND renewal.start_date <= %[1]s AND %[2]s > %[1]s
+		)
+) coverage ON active_policy.id IS NOT NULL
+WHERE cars.deleted_at IS NULL AND cars.status NOT IN @final
+	AND (active_policy.id IS NULL OR coverage.covered_until <= @until)
+ORDER BY active_policy.id IS NOT NULL, coverage.covered_until, cars.created_at`,
+	effectiveEndOf("policy"), effectiveEndOf("renewal"))
+
+// carCoverageRow es una fila de coverageAlertsQuery; la póliza vigente llega con el prefijo policy_
+type carCoverageRow struct {
+	entities.Car
+	Policy       entities.InsurancePolicy `gorm:"embedded;embeddedPrefix:policy_"`
+	CoveredUntil *time.Time
+}
+
+// InsurancePolicyRepository implementa la interfaz repositories.InsurancePolicyRepository usando GORM
+type InsurancePolicyRepository struct {
+	db *gorm.DB
+}
+
+// NewInsurancePolicyRepository crea una nueva instancia de InsurancePolicyRepository
+func NewInsurancePolicyRepository(db *gorm.DB) repositories.InsurancePolicyRepository {
+	return &InsurancePolicyRepository{
+		db: db,
+	}
+}
+
+// Create guarda una nueva póliza
+func (r *InsurancePolicyRepository) Create(ctx context.Context, policy *entities.InsurancePolicy) error {
+	return conn(ctx, r.db).Create(policy).Error
+}
+
+// GetByID obtiene una póliza de un auto por su ID
+func (r *InsurancePolicyRepository) GetByID(ctx context.Context, carID, id uuid.UUID) (*entities.InsurancePolicy, error) {
+	var policy entities.InsurancePolicy
+	err := conn(ctx, r.db).First(&policy, "id = ? AND car_id = ?", id, carID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// Update actualiza una póliza existente
+func (r *InsurancePolicyRepository) Update(ctx context.Context, policy *entities.InsurancePolicy) error {
+	return conn(ctx, r.db).Save(policy).Error
+}
+
+// GetByNumber obtiene la póliza de una aseguradora por su número
+func (r *InsurancePolicyRepository) GetByNumber(ctx context.Context, insurer, policyNumber string) (*entities.InsurancePolicy, error) {
+	return r.first(ctx, "insurer = ? AND policy_number = ?", insurer, policyNumber)
+}
+
+// GetOverlapping obtiene una póliza del auto cuya cobertura se superpone con el período indicado
+func (r *InsurancePolicyRepository) GetOverlapping(ctx context.Context, carID uuid.UUID, start, end time.Time) (*entities.InsurancePolicy, error) {
+	return r.first(ctx, "car_id = ? AND start_date < ? AND "+effectiveEndColumn+" > ? AND "+effectiveEndColumn+" > start_date",
+		carID, end, start)
+}
+
+// ListByCarID obtiene las pólizas de un auto, de la más reciente a la más antigua
+func (r *InsurancePolicyRepository) ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.InsurancePolicy, error) {
+	var policies []*entities.InsurancePolicy
+	err := conn(ctx, r.db).
+		Where("car_id = ?", carID).
+		Order("start_date DESC").
+		Find(&policies).Error
+	return policies, err
+}
+
+// ListByOwnerID obtiene las pólizas contratadas por un propietario, de la más reciente a la más antigua
+func (r *InsurancePolicyRepository) ListByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*entities.InsurancePolicy, error) {
+	var policies []*entities.InsurancePolicy
+	err := conn(ctx, r.db).
+		Where("owner_id = ?", ownerID).
+		Order("start_date DESC").
+		Find(&policies).Error
+	return policies, err
+}
+
+// ListCoverageAlerts obtiene en una única consulta los autos sin seguro y los que pierden la cobertura hasta until
+func (r *InsurancePolicyRepository) ListCoverageAlerts(ctx context.Context, at, until time.Time) ([]*repositories.CarCoverage, error) {
+	stmt := &gorm.Statement{DB: r.db}
+	if err := stmt.Parse(&entities.InsurancePolicy{}); err != nil {
+		return nil, err
+	}
+	policyColumns := make([]string, len(stmt.Schema.DBNames))
+	for i, column := range stmt.Schema.DBNames {
+		policyColumns[i] = fmt.Sprintf("active_policy.%s AS policy_%s", column, column)
+	}
+
+	var finalStatuses []entities.CarStatus
+	for _, status := range entities.CarStatuses {
+		if status.IsFinal() {
+			finalStatuses = append(finalStatuses, status)
+		}
+	}
+
+	var rows []*carCoverageRow
+	err := conn(ctx, r.db).
+		Raw(fmt.Sprintf(coverageAlertsQuery, strings.Join(policyColumns, ", ")),
+			sql.Named("at", at), sql.Named("until", until), sql.Named("final", finalStatuses)).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	coverages := make([]*repositories.CarCoverage, len(rows))
+	for i, row := range rows {
+		car := row.Car
+		coverages[i] = &repositories.CarCoverage{Car: &car}
+		if row.Policy.ID != uuid.Nil {
+			policy := row.Policy
+			coverages[i].Policy = &policy
+			coverages[i].CoveredUntil = row.CoveredUntil
+		}
+	}
+	return coverages, nil
+}
+
+// ListUnexpiredByCarID obtiene las pólizas de un auto vigentes o futuras a la fecha indicada
+func (r *InsurancePolicyRepository) ListUnexpiredByCarID(ctx context.Context, carID uuid.UUID, at time.Time) ([]*entities.InsurancePolicy, error) {
+	var policies []*entities.InsurancePolicy
+	err := conn(ctx, r.db).
+		Where("car_id = ?", carID).
+		Where(effectiveEndColumn+" > ?", at).
+		Order("start_date").
+		Find(&policies).Error
+	return policies, err
+}
+
+func (r *InsurancePolicyRepository) first(ctx context.Context, query string, args ...any) (*entities.InsurancePolicy, error) {
+	var policies []*entities.InsurancePolicy
+	err := conn(ctx, r.db).
+		Where(query, args...).
+		Limit(1).
+		Find(&policies).Error
+	if err != nil || len(policies) == 0 {
+		return nil, err
+	}
+	return policies[0], nil
+}
@@ -0,0 +1,216 @@
+//go:build integration
+
+package gorm_test
+
+import (
+	"car-service/internal/domain/entities"
+	gormrepo "car-service/internal/infrastructure/gorm"
+	"car-service/internal/infrastructure/migrations"
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// openTestDB abre la base de datos de TEST_DATABASE_DSN, aplica las migraciones y retorna una
+// transacción que se revierte al terminar el test. Sin TEST_DATABASE_DSN el test se omite.
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN no está definida")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
+	if err != nil {
+		t.Fatalf("no se pudo conectar a la base de datos: %v", err)
+	}
+	if err := migrations.Migrate(db); err != nil {
+		t.Fatalf("no se pudieron aplicar las migraciones: %v", err)
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		t.Fatalf("no se pudo iniciar la transacción: %v", tx.Error)
+	}
+	t.Cleanup(func() {
+		tx.Rollback()
+	})
+	return tx
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// coverageFixture crea autos y pólizas de un mismo modelo y propietario
+type coverageFixture struct {
+	t     *testing.T
+	db    *gorm.DB
+	model *entities.Model
+	owner *entities.Owner
+}
+
+func newCoverageFixture(t *testing.T, db *gorm.DB) *coverageFixture {
+	suffix := uuid.NewString()
+	brand := entities.NewBrand("Marca "+suffix, "AR", "")
+	model := entities.NewModel("Modelo "+suffix, brand.ID, 2015, "Sedan")
+	owner := entities.NewOwner("Ana", suffix+"@example.com", "", "")
+	for _, value := range []any{brand, model, owner} {
+		if err := db.Create(value).Error; err != nil {
+			t.Fatalf("no se pudo crear %T: %v", value, err)
+		}
+	}
+	return &coverageFixture{t: t, db: db, model: model, owner: owner}
+}
+
+func (f *coverageFixture) car(status entities.CarStatus) *entities.Car {
+	vin := strings.ToUpper(strings.ReplaceAll(uuid.NewString(), "-", ""))[:17]
+	car := entities.NewCar(f.model.ID, 2020, "Rojo", vin, f.owner.ID)
+	car.Status = status
+	if err := f.db.Create(car).Error; err != nil {
+		f.t.Fatalf("no se pudo crear el auto: %v", err)
+	}
+	return car
+}
+
+func (f *coverageFixture) policy(car *entities.Car, start, end time.Time, terminated *time.Time) *entities.InsurancePolicy {
+	policy := entities.NewInsurancePolicy(car.ID, f.owner.ID, "Aseguradora", uuid.NewString(),
+		entities.CoverageTypeLiability, start, end, 1000, "")
+	policy.TerminatedAt = terminated
+	if err := f.db.Create(policy).Error; err != nil {
+		f.t.Fatalf("no se pudo crear la póliza: %v", err)
+	}
+	return policy
+}
+
+func (f *coverageFixture) softDelete(value any) {
+	if err := f.db.Delete(value).Error; err != nil {
+		f.t.Fatalf("no se pudo eliminar %T: %v", value, err)
+	}
+}
+
+func TestListCoverageAlerts(t *testing.T) {
+	db := openTestDB(t)
+	f := newCoverageFixture(t, db)
+	at, until := date(2024, time.June, 1), date(2024, time.July, 1)
+	terminatedEarly, terminatedBefore, terminatedRenewal := date(2024, time.June, 15), date(2024, time.May, 1), date(2024, time.June, 25)
+
+	// Un coveredUntil vacío indica que el auto debe figurar sin seguro
+	type expectation struct {
+		name         string
+		car          *entities.Car
+		alert        bool
+		coveredUntil time.Time
+	}
+	var expectations []expectation
+	expect := func(name string, car *entities.Car, alert bool, coveredUntil time.Time) {
+		expectations = append(expectations, expectation{name: name, car: car, alert: alert, coveredUntil: coveredUntil})
+	}
+
+	expect("sin pólizas", f.car(entities.CarStatusRegistered), true, time.Time{})
+
+	car := f.car(entities.CarStatusRegistered)
+	f.policy(car, date(2024, time.January, 1), date(2025, time.January, 1), nil)
+	expect("cobertura posterior al período", car, false, time.Time{})
+
+	car = f.car(entities.CarStatusRegistered)
+	f.policy(car, date(2023, time.June, 20), date(2024, time.June, 20), nil)
+	expect("vence dentro del período", car, true, date(2024, time.June, 20))
+
+	car = f.car(entities.CarStatusRegistered)
+	f.policy(car, date(2023, time.June, 20), date(2024, time.June, 20), nil)
+	f.policy(car, date(2024, time.June, 20), date(2025, time.June, 20), nil)
+	expect("renovación encadenada", car, false, time.Time{})
+
+	car = f.car(entities.CarStatusRegistered)
+	f.policy(car, date(2023, time.June, 10), date(2024, time.June, 10), nil)
+	f.policy(car, date(2024, time.June, 10), date(2024, time.June, 25), nil)
+	expect("renovación que también vence en el período", car, true, date(2024, time.June, 25))
+
+	car = f.car(entities.CarStatusRegistered)
+	f.policy(car, date(2023, time.June, 20), date(2024, time.June, 20), nil)
+	f.policy(car, date(2024, time.June, 22), date(2025, time.June, 22), nil)
+	expect("hueco entre pólizas", car, true, date(2024, time.June, 20))
+
+	car = f.car(entities.CarStatusRegistered)
+	f.policy(car, date(2024, time.January, 1), date(2025, time.January, 1), &terminatedEarly)
+	expect("baja anticipada dentro del período", car, true, terminatedEarly)
+
+	car = f.car(entities.CarStatusRegistered)
+	f.policy(car, date(2024, time.January, 1), date(2025, time.January, 1), &terminatedBefore)
+	expect("baja anterior a la fecha", car, true, time.Time{})
+
+	car = f.car(entities.CarStatusRegistered)
+	f.policy(car, date(2023, time.June, 20), date(2024, time.June, 20), nil)
+	f.policy(car, date(2024, time.June, 20), date(2025, time.June, 20), &terminatedRenewal)
+	expect("renovación dada de baja", car, true, terminatedRenewal)
+
+	car = f.car(entities.CarStatusRegistered)
+	f.softDelete(f.policy(car, date(2024, time.January, 1), date(2025, time.January, 1), nil))
+	expect("única póliza eliminada", car, true, time.Time{})
+
+	car = f.car(entities.CarStatusRegistered)
+	f.policy(car, date(2023, time.June, 20), date(2024, time.June, 20), nil)
+	f.softDelete(f.policy(car, date(2024, time.June, 20), date(2025, time.June, 20), nil))
+	expect("renovación eliminada", car, true, date(2024, time.June, 20))
+
+	car = f.car(entities.CarStatusRegistered)
+	f.softDelete(car)
+	expect("auto eliminado", car, false, time.Time{})
+
+	expect("auto en estado final", f.car(entities.CarStatusScrapped), false, time.Time{})
+
+	coverages, err := gormrepo.NewInsurancePolicyRepository(db).ListCoverageAlerts(context.Background(), at, until)
+	if err != nil {
+		t.Fatalf("error inesperado: %v", err)
+	}
+
+	alerts := make(map[uuid.UUID]int)
+	for i, coverage := range coverages {
+		alerts[coverage.Car.ID] = i
+	}
+	for _, e := range expectations {
+		i, found := alerts[e.car.ID]
+		if found != e.alert {
+			t.Errorf("%s: alerta = %v, se esperaba %v", e.name, found, e.alert)
+			continue
+		}
+		if !found {
+			continue
+		}
+
+		coverage := coverages[i]
+		if e.coveredUntil.IsZero() {
+			if coverage.Policy != nil || coverage.CoveredUntil != nil {
+				t.Errorf("%s: se esperaba un auto sin seguro, cubierto hasta %v", e.name, coverage.CoveredUntil)
+			}
+			continue
+		}
+		if coverage.Policy == nil || coverage.CoveredUntil == nil || !coverage.CoveredUntil.Equal(e.coveredUntil) {
+			t.Errorf("%s: cubierto hasta %v, se esperaba %v", e.name, coverage.CoveredUntil, e.coveredUntil)
+		}
+	}
+
+	// Los autos sin seguro se listan primero y los asegurados por fin de cobertura
+	var previous *time.Time
+	insured := false
+	for _, coverage := range coverages {
+		if coverage.Policy == nil {
+			if insured {
+				t.Fatalf("el auto sin seguro %s se listó después de un auto asegurado", coverage.Car.ID)
+			}
+			continue
+		}
+		insured = true
+		if previous != nil && coverage.CoveredUntil.Before(*previous) {
+			t.Fatalf("las alertas no están ordenadas por fin de cobertura: %v antes de %v", previous, coverage.CoveredUntil)
+		}
+		previous = coverage.CoveredUntil
+	}
+}
@@ -0,0 +1,44 @@
+package gorm
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ManufacturerIdentifierRepository implementa la interfaz repositories.ManufacturerIdentifierRepository usando GORM
+type ManufacturerIdentifierRepository struct {
+	db *gorm.DB
+}
+
+// NewManufacturerIdentifierRepository crea una nueva instancia de ManufacturerIdentifierRepository
+func NewManufacturerIdentifierRepository(db *gorm.DB) repositories.ManufacturerIdentifierRepository {
+	return &ManufacturerIdentifierRepository{
+		db: db,
+	}
+}
+
+// Create guarda un nuevo WMI en la base de datos
+func (r *ManufacturerIdentifierRepository) Create(ctx context.Context, identifier *entities.ManufacturerIdentifier) error {
+	return conn(ctx, r.db).Create(identifier).Error
+}
+
+// GetByCode obtiene un WMI por su código, incluyendo la marca asociada
+func (r *ManufacturerIdentifierRepository) GetByCode(ctx context.Context, code string) (*entities.ManufacturerIdentifier, error) {
+	var identifiers []*entities.ManufacturerIdentifier
+	err := conn(ctx, r.db).Preload("Brand").Where("code = ?", code).Limit(1).Find(&identifiers).Error
+	if err != nil || len(identifiers) == 0 {
+		return nil, err
+	}
+	return identifiers[0], nil
+}
+
+// GetByBrandID obtiene todos los WMI de una marca
+func (r *ManufacturerIdentifierRepository) GetByBrandID(ctx context.Context, brandID uuid.UUID) ([]*entities.ManufacturerIdentifier, error) {
+	var identifiers []*entities.ManufacturerIdentifier
+	err := conn(ctx, r.db).Where("brand_id = ?", brandID).Find(&identifiers).Error
+	return identifiers, err
+}
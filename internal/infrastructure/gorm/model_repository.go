@@ -3,6 +3,7 @@ package gorm
 import (
 	"car-service/internal/domain/entities"
 	"car-service/internal/domain/repositories"
+	"context"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -21,14 +22,14 @@ func NewModelRepository(db *gorm.DB) repositories.ModelRepository {
 }
 
 // Create guarda un nuevo modelo en la base de datos
-func (r *ModelRepository) Create(model *entities.Model) error {
-	return r.db.Create(model).Error
+func (r *ModelRepository) Create(ctx context.Context, model *entities.Model) error {
+	return conn(ctx, r.db).Create(model).Error
 }
 
 // GetByID obtiene un modelo por su ID
-func (r *ModelRepository) GetByID(id uuid.UUID) (*entities.Model, error) {
+func (r *ModelRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Model, error) {
 	var model entities.Model
-	err := r.db.First(&model, "id = ?", id).Error
+	err := conn(ctx, r.db).First(&model, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -36,23 +37,23 @@ func (r *ModelRepository) GetByID(id uuid.UUID) (*entities.Model, error) {
 }
 
 // ExistsByID verifica si existe un modelo con el ID proporcionado
-func (r *ModelRepository) ExistsByID(id uuid.UUID) (bool, error) {
+func (r *ModelRepository) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	err := r.db.Model(&entities.Model{}).Where("id = ?", id).Count(&count).Error
+	err := conn(ctx, r.db).Model(&entities.Model{}).Where("id = ?", id).Count(&count).Error
 	return count > 0, err
 }
 
 // GetByBrandID obtiene todos los modelos de una marca
-func (r *ModelRepository) GetByBrandID(brandID uuid.UUID) ([]*entities.Model, error) {
+func (r *ModelRepository) GetByBrandID(ctx context.Context, brandID uuid.UUID) ([]*entities.Model, error) {
 	var models []*entities.Model
-	err := r.db.Where("brand_id = ?", brandID).Find(&models).Error
+	err := conn(ctx, r.db).Where("brand_id = ?", brandID).Find(&models).Error
 	return models, err
 }
 
 // GetByNameAndBrand obtiene un modelo por su nombre y marca
-func (r *ModelRepository) GetByNameAndBrand(name string, brandID uuid.UUID) (*entities.Model, error) {
+func (r *ModelRepository) GetByNameAndBrand(ctx context.Context, name string, brandID uuid.UUID) (*entities.Model, error) {
 	var model entities.Model
-	err := r.db.Where("name = ? AND brand_id = ?", name, brandID).First(&model).Error
+	err := conn(ctx, r.db).Where("name = ? AND brand_id = ?", name, brandID).First(&model).Error
 	if err != nil {
 		return nil, err
 	}
@@ -60,32 +61,41 @@ func (r *ModelRepository) GetByNameAndBrand(name string, brandID uuid.UUID) (*en
 }
 
 // Update actualiza un modelo existente
-func (r *ModelRepository) Update(model *entities.Model) error {
-	return r.db.Save(model).Error
+func (r *ModelRepository) Update(ctx context.Context, model *entities.Model) error {
+	return conn(ctx, r.db).Save(model).Error
 }
 
 // Delete elimina un modelo por su ID
-func (r *ModelRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&entities.Model{}, "id = ?", id).Error
+func (r *ModelRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&entities.Model{}, "id = ?", id).Error
 }
 
 // List obtiene todos los modelos
-func (r *ModelRepository) List() ([]*entities.Model, error) {
+func (r *ModelRepository) List(ctx context.Context) ([]*entities.Model, error) {
 	var models []*entities.Model
-	err := r.db.Find(&models).Error
+	err := conn(ctx, r.db).Find(&models).Error
 	return models, err
 }
 
 // ListActive obtiene todos los modelos activos
-func (r *ModelRepository) ListActive() ([]*entities.Model, error) {
+func (r *ModelRepository) ListActive(ctx context.Context) ([]*entities.Model, error) {
 	var models []*entities.Model
-	err := r.db.Where("active = ?", true).Find(&models).Error
+	err := conn(ctx, r.db).Where("active = ?", true).Find(&models).Error
 	return models, err
 }
 
 // ListByCategory obtiene todos los modelos de una categoría
-func (r *ModelRepository) ListByCategory(category string) ([]*entities.Model, error) {
+func (r *ModelRepository) ListByCategory(ctx context.Context, category string) ([]*entities.Model, error) {
 	var models []*entities.Model
-	err := r.db.Where("category = ?", category).Find(&models).Error
+	err := conn(ctx, r.db).Where("category = ?", category).Find(&models).Error
+	return models, err
+}
+
+// ListBySpecs obtiene los modelos con al menos una versión que cumple el filtro
+func (r *ModelRepository) ListBySpecs(ctx context.Context, specs repositories.SpecFilter) ([]*entities.Model, error) {
+	var models []*entities.Model
+	err := conn(ctx, r.db).
+		Where("EXISTS (?)", trimSpecQuery(conn(ctx, r.db), specs).Where("model_trims.model_id = models.id")).
+		Find(&models).Error
 	return models, err
 }
@@ -0,0 +1,111 @@
+package gorm
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ModelTrimRepository implementa la interfaz repositories.ModelTrimRepository usando GORM
+type ModelTrimRepository struct {
+	db *gorm.DB
+}
+
+// NewModelTrimRepository crea una nueva instancia de ModelTrimRepository
+func NewModelTrimRepository(db *gorm.DB) repositories.ModelTrimRepository {
+	return &ModelTrimRepository{
+		db: db,
+	}
+}
+
+// Create guarda una nueva versión
+func (r *ModelTrimRepository) Create(ctx context.Context, trim *entities.ModelTrim) error {
+	return conn(ctx, r.db).Create(trim).Error
+}
+
+// GetByID obtiene una versión de un modelo
+func (r *ModelTrimRepository) GetByID(ctx context.Context, modelID, id uuid.UUID) (*entities.ModelTrim, error) {
+	var trim entities.ModelTrim
+	err := conn(ctx, r.db).First(&trim, "id = ? AND model_id = ?", id, modelID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &trim, nil
+}
+
+// GetByNameAndModel obtiene una versión por su nombre y modelo
+func (r *ModelTrimRepository) GetByNameAndModel(ctx context.Context, name string, modelID uuid.UUID) (*entities.ModelTrim, error) {
+	var trims []*entities.ModelTrim
+	err := conn(ctx, r.db).
+		Where("name = ? AND model_id = ?", name, modelID).
+		Limit(1).
+		Find(&trims).Error
+	if err != nil || len(trims) == 0 {
+		return nil, err
+	}
+	return trims[0], nil
+}
+
+// Update actualiza una versión existente
+func (r *ModelTrimRepository) Update(ctx context.Context, trim *entities.ModelTrim) error {
+	return conn(ctx, r.db).Omit("Model").Save(trim).Error
+}
+
+// Delete elimina una versión por su ID
+func (r *ModelTrimRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&entities.ModelTrim{}, "id = ?", id).Error
+}
+
+// ListByModelID obtiene las versiones de un modelo ordenadas por nombre
+func (r *ModelTrimRepository) ListByModelID(ctx context.Context, modelID uuid.UUID) ([]*entities.ModelTrim, error) {
+	var trims []*entities.ModelTrim
+	err := conn(ctx, r.db).
+		Where("model_id = ?", modelID).
+		Order("name").
+		Find(&trims).Error
+	return trims, err
+}
+
+// trimSpecQuery construye la subconsulta de versiones que cumplen el filtro de ficha técnica.
+// Quien la usa agrega la condición que la correlaciona con la consulta principal.
+func trimSpecQuery(db *gorm.DB, specs repositories.SpecFilter) *gorm.DB {
+	query := db.Model(&entities.ModelTrim{}).Select("1")
+	if specs.FuelType != "" {
+		query = query.Where("model_trims.fuel_type = ?", specs.FuelType)
+	}
+	if specs.Transmission != "" {
+		query = query.Where("model_trims.transmission = ?", specs.Transmission)
+	}
+	if specs.Drivetrain != "" {
+		query = query.Where("model_trims.drivetrain = ?", specs.Drivetrain)
+	}
+	if specs.Doors != 0 {
+		query = query.Where("model_trims.doors = ?", specs.Doors)
+	}
+	if specs.Seats != 0 {
+		query = query.Where("model_trims.seats >= ?", specs.Seats)
+	}
+	if specs.PowerFrom != 0 {
+		query = query.Where("model_trims.power >= ?", specs.PowerFrom)
+	}
+	if specs.PowerTo != 0 {
+		query = query.Where("model_trims.power <= ?", specs.PowerTo)
+	}
+	if specs.DisplacementFrom != 0 {
+		query = query.Where("model_trims.engine_displacement >= ?", specs.DisplacementFrom)
+	}
+	if specs.DisplacementTo != 0 {
+		query = query.Where("model_trims.engine_displacement <= ?", specs.DisplacementTo)
+	}
+	if specs.CurbWeightTo != 0 {
+		query = query.Where("model_trims.curb_weight > 0 AND model_trims.curb_weight <= ?", specs.CurbWeightTo)
+	}
+	if specs.CO2To != 0 {
+		query = query.Where("(model_trims.co2_emissions > 0 AND model_trims.co2_emissions <= ?) OR model_trims.fuel_type = ?",
+			specs.CO2To, entities.FuelTypeElectric)
+	}
+	return query
+}
@@ -0,0 +1,62 @@
+package gorm
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OdometerReadingRepository implementa la interfaz repositories.OdometerReadingRepository usando GORM
+type OdometerReadingRepository struct {
+	db *gorm.DB
+}
+
+// NewOdometerReadingRepository crea una nueva instancia de OdometerReadingRepository
+func NewOdometerReadingRepository(db *gorm.DB) repositories.OdometerReadingRepository {
+	return &OdometerReadingRepository{
+		db: db,
+	}
+}
+
+// Create guarda una nueva lectura de kilometraje
+func (r *OdometerReadingRepository) Create(ctx context.Context, reading *entities.OdometerReading) error {
+	return conn(ctx, r.db).Create(reading).Error
+}
+
+// Update actualiza una lectura existente
+func (r *OdometerReadingRepository) Update(ctx context.Context, reading *entities.OdometerReading) error {
+	return conn(ctx, r.db).Save(reading).Error
+}
+
+// GetBySource obtiene la lectura generada por un registro de origen (servicio o inspección)
+func (r *OdometerReadingRepository) GetBySource(ctx context.Context, source entities.OdometerSource, sourceID uuid.UUID) (*entities.OdometerReading, error) {
+	var readings []*entities.OdometerReading
+	err := conn(ctx, r.db).
+		Where("source = ? AND source_id = ?", source, sourceID).
+		Limit(1).
+		Find(&readings).Error
+	if err != nil || len(readings) == 0 {
+		return nil, err
+	}
+	return readings[0], nil
+}
+
+// DeleteBySource elimina la lectura generada por un registro de origen
+func (r *OdometerReadingRepository) DeleteBySource(ctx context.Context, source entities.OdometerSource, sourceID uuid.UUID) error {
+	return conn(ctx, r.db).
+		Where("source = ? AND source_id = ?", source, sourceID).
+		Delete(&entities.OdometerReading{}).Error
+}
+
+// ListByCarID obtiene las lecturas de un auto en orden cronológico
+func (r *OdometerReadingRepository) ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.OdometerReading, error) {
+	var readings []*entities.OdometerReading
+	err := conn(ctx, r.db).
+		Where("car_id = ?", carID).
+		Order("date ASC, created_at ASC").
+		Find(&readings).Error
+	return readings, err
+}
@@ -3,6 +3,7 @@ package gorm
 import (
 	"car-service/internal/domain/entities"
 	"car-service/internal/domain/repositories"
+	"context"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -21,14 +22,14 @@ func NewOwnerRepository(db *gorm.DB) repositories.OwnerRepository {
 }
 
 // Create guarda un nuevo propietario en la base de datos
-func (r *OwnerRepository) Create(owner *entities.Owner) error {
-	return r.db.Create(owner).Error
+func (r *OwnerRepository) Create(ctx context.Context, owner *entities.Owner) error {
+	return conn(ctx, r.db).Create(owner).Error
 }
 
 // GetByID obtiene un propietario por su ID
-func (r *OwnerRepository) GetByID(id uuid.UUID) (*entities.Owner, error) {
+func (r *OwnerRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Owner, error) {
 	var owner entities.Owner
-	err := r.db.First(&owner, "id = ?", id).Error
+	err := conn(ctx, r.db).First(&owner, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -36,16 +37,16 @@ func (r *OwnerRepository) GetByID(id uuid.UUID) (*entities.Owner, error) {
 }
 
 // ExistsByID verifica si existe un propietario con el ID proporcionado
-func (r *OwnerRepository) ExistsByID(id uuid.UUID) (bool, error) {
+func (r *OwnerRepository) ExistsByID(ctx context.Context, id uuid.UUID) (bool, error) {
 	var count int64
-	err := r.db.Model(&entities.Owner{}).Where("id = ?", id).Count(&count).Error
+	err := conn(ctx, r.db).Model(&entities.Owner{}).Where("id = ?", id).Count(&count).Error
 	return count > 0, err
 }
 
 // GetByEmail obtiene un propietario por su email
-func (r *OwnerRepository) GetByEmail(email string) (*entities.Owner, error) {
+func (r *OwnerRepository) GetByEmail(ctx context.Context, email string) (*entities.Owner, error) {
 	var owner entities.Owner
-	err := r.db.Where("email = ?", email).First(&owner).Error
+	err := conn(ctx, r.db).Where("email = ?", email).First(&owner).Error
 	if err != nil {
 		return nil, err
 	}
@@ -53,18 +54,18 @@ func (r *OwnerRepository) GetByEmail(email string) (*entities.Owner, error) {
 }
 
 // Update actualiza un propietario existente
-func (r *OwnerRepository) Update(owner *entities.Owner) error {
-	return r.db.Save(owner).Error
+func (r *OwnerRepository) Update(ctx context.Context, owner *entities.Owner) error {
+	return conn(ctx, r.db).Save(owner).Error
 }
 
 // Delete elimina un propietario por su ID
-func (r *OwnerRepository) Delete(id uuid.UUID) error {
-	return r.db.Delete(&entities.Owner{}, "id = ?", id).Error
+func (r *OwnerRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&entities.Owner{}, "id = ?", id).Error
 }
 
 // List obtiene todos los propietarios
-func (r *OwnerRepository) List() ([]*entities.Owner, error) {
+func (r *OwnerRepository) List(ctx context.Context) ([]*entities.Owner, error) {
 	var owners []*entities.Owner
-	err := r.db.Find(&owners).Error
+	err := conn(ctx, r.db).Find(&owners).Error
 	return owners, err
 }
@@ -0,0 +1,58 @@
+package gorm
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OwnershipRecordRepository implementa la interfaz repositories.OwnershipRecordRepository usando GORM
+type OwnershipRecordRepository struct {
+	db *gorm.DB
+}
+
+// NewOwnershipRecordRepository crea una nueva instancia de OwnershipRecordRepository
+func NewOwnershipRecordRepository(db *gorm.DB) repositories.OwnershipRecordRepository {
+	return &OwnershipRecordRepository{
+		db: db,
+	}
+}
+
+// Create guarda un nuevo registro de propiedad
+func (r *OwnershipRecordRepository) Create(ctx context.Context, record *entities.OwnershipRecord) error {
+	return conn(ctx, r.db).Create(record).Error
+}
+
+// Update actualiza un registro de propiedad existente
+func (r *OwnershipRecordRepository) Update(ctx context.Context, record *entities.OwnershipRecord) error {
+	return conn(ctx, r.db).Save(record).Error
+}
+
+// GetCurrentByCarID obtiene el registro vigente (sin fecha de fin) de un auto.
+// Retorna nil sin error si el auto no tiene un registro vigente.
+func (r *OwnershipRecordRepository) GetCurrentByCarID(ctx context.Context, carID uuid.UUID) (*entities.OwnershipRecord, error) {
+	var records []*entities.OwnershipRecord
+	err := conn(ctx, r.db).
+		Where("car_id = ? AND to_date IS NULL", carID).
+		Order("from_date DESC").
+		Limit(1).
+		Find(&records).Error
+	if err != nil || len(records) == 0 {
+		return nil, err
+	}
+	return records[0], nil
+}
+
+// ListByCarID obtiene el historial de propietarios de un auto, del más reciente al más antiguo
+func (r *OwnershipRecordRepository) ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.OwnershipRecord, error) {
+	var records []*entities.OwnershipRecord
+	err := conn(ctx, r.db).
+		Preload("Owner").
+		Where("car_id = ?", carID).
+		Order("from_date DESC").
+		Find(&records).Error
+	return records, err
+}
@@ -0,0 +1,126 @@
+package gorm
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RecallRepository implementa la interfaz repositories.RecallRepository usando GORM
+type RecallRepository struct {
+	db *gorm.DB
+}
+
+// NewRecallRepository crea una nueva instancia de RecallRepository
+func NewRecallRepository(db *gorm.DB) repositories.RecallRepository {
+	return &RecallRepository{
+		db: db,
+	}
+}
+
+// Create guarda una nueva campaña
+func (r *RecallRepository) Create(ctx context.Context, recall *entities.Recall) error {
+	return conn(ctx, r.db).Create(recall).Error
+}
+
+// GetByID obtiene una campaña con su modelo
+func (r *RecallRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Recall, error) {
+	var recall entities.Recall
+	err := conn(ctx, r.db).
+		Preload("Model").
+		First(&recall, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &recall, nil
+}
+
+// GetByCampaign obtiene una campaña por su número
+func (r *RecallRepository) GetByCampaign(ctx context.Context, campaign string) (*entities.Recall, error) {
+	var recalls []*entities.Recall
+	err := conn(ctx, r.db).
+		Where("campaign = ?", campaign).
+		Limit(1).
+		Find(&recalls).Error
+	if err != nil || len(recalls) == 0 {
+		return nil, err
+	}
+	return recalls[0], nil
+}
+
+// ListByModelID obtiene las campañas de un modelo
+func (r *RecallRepository) ListByModelID(ctx context.Context, modelID uuid.UUID) ([]*entities.Recall, error) {
+	var recalls []*entities.Recall
+	err := conn(ctx, r.db).
+		Where("model_id = ?", modelID).
+		Order("issued_at DESC").
+		Find(&recalls).Error
+	return recalls, err
+}
+
+// CarRecallRepository implementa la interfaz repositories.CarRecallRepository usando GORM
+type CarRecallRepository struct {
+	db *gorm.DB
+}
+
+// NewCarRecallRepository crea una nueva instancia de CarRecallRepository
+func NewCarRecallRepository(db *gorm.DB) repositories.CarRecallRepository {
+	return &CarRecallRepository{
+		db: db,
+	}
+}
+
+// CreateBatch guarda los vehículos alcanzados por una campaña
+func (r *CarRecallRepository) CreateBatch(ctx context.Context, carRecalls []*entities.CarRecall) error {
+	if len(carRecalls) == 0 {
+		return nil
+	}
+	return conn(ctx, r.db).CreateInBatches(carRecalls, 100).Error
+}
+
+// GetByCarAndRecall obtiene el seguimiento de una campaña para un auto
+func (r *CarRecallRepository) GetByCarAndRecall(ctx context.Context, carID, recallID uuid.UUID) (*entities.CarRecall, error) {
+	var carRecall entities.CarRecall
+	err := conn(ctx, r.db).
+		Preload("Recall").
+		First(&carRecall, "car_id = ? AND recall_id = ?", carID, recallID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &carRecall, nil
+}
+
+// Update actualiza el seguimiento de una campaña para un auto
+func (r *CarRecallRepository) Update(ctx context.Context, carRecall *entities.CarRecall) error {
+	return conn(ctx, r.db).Omit("Recall", "Car").Save(carRecall).Error
+}
+
+// Delete elimina el seguimiento de una campaña para un auto por su ID
+func (r *CarRecallRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&entities.CarRecall{}, "id = ?", id).Error
+}
+
+// ListByCarID obtiene las campañas que alcanzan a un auto, de la más reciente a la más antigua
+func (r *CarRecallRepository) ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.CarRecall, error) {
+	var carRecalls []*entities.CarRecall
+	err := conn(ctx, r.db).
+		InnerJoins("Recall").
+		Where("car_recalls.car_id = ?", carID).
+		Order("\"Recall\".issued_at DESC").
+		Find(&carRecalls).Error
+	return carRecalls, err
+}
+
+// ListByRecallID obtiene los autos alcanzados por una campaña
+func (r *CarRecallRepository) ListByRecallID(ctx context.Context, recallID uuid.UUID) ([]*entities.CarRecall, error) {
+	var carRecalls []*entities.CarRecall
+	err := conn(ctx, r.db).
+		InnerJoins("Car").
+		Where("car_recalls.recall_id = ?", recallID).
+		Order("\"Car\".vin").
+		Find(&carRecalls).Error
+	return carRecalls, err
+}
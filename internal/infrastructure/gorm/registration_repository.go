@@ -0,0 +1,75 @@
+package gorm
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RegistrationRepository implementa la interfaz repositories.RegistrationRepository usando GORM
+type RegistrationRepository struct {
+	db *gorm.DB
+}
+
+// NewRegistrationRepository crea una nueva instancia de RegistrationRepository
+func NewRegistrationRepository(db *gorm.DB) repositories.RegistrationRepository {
+	return &RegistrationRepository{
+		db: db,
+	}
+}
+
+// Create guarda una nueva patente
+func (r *RegistrationRepository) Create(ctx context.Context, registration *entities.Registration) error {
+	return conn(ctx, r.db).Create(registration).Error
+}
+
+// GetByID obtiene una patente de un auto por su ID
+func (r *RegistrationRepository) GetByID(ctx context.Context, carID, id uuid.UUID) (*entities.Registration, error) {
+	var registration entities.Registration
+	err := conn(ctx, r.db).First(&registration, "id = ? AND car_id = ?", id, carID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &registration, nil
+}
+
+// Update actualiza una patente existente
+func (r *RegistrationRepository) Update(ctx context.Context, registration *entities.Registration) error {
+	return conn(ctx, r.db).Save(registration).Error
+}
+
+// GetActiveByPlate obtiene la patente activa con el país y el número indicados
+func (r *RegistrationRepository) GetActiveByPlate(ctx context.Context, country, plate string) (*entities.Registration, error) {
+	return r.firstActive(ctx, "country = ? AND plate = ?", country, plate)
+}
+
+// GetActiveByCarID obtiene la patente activa de un auto
+func (r *RegistrationRepository) GetActiveByCarID(ctx context.Context, carID uuid.UUID) (*entities.Registration, error) {
+	return r.firstActive(ctx, "car_id = ?", carID)
+}
+
+// ListByCarID obtiene las patentes de un auto, de la más reciente a la más antigua
+func (r *RegistrationRepository) ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.Registration, error) {
+	var registrations []*entities.Registration
+	err := conn(ctx, r.db).
+		Where("car_id = ?", carID).
+		Order("issued_at DESC").
+		Find(&registrations).Error
+	return registrations, err
+}
+
+func (r *RegistrationRepository) firstActive(ctx context.Context, query string, args ...any) (*entities.Registration, error) {
+	var registrations []*entities.Registration
+	err := conn(ctx, r.db).
+		Where(query, args...).
+		Where("cancelled_at IS NULL").
+		Limit(1).
+		Find(&registrations).Error
+	if err != nil || len(registrations) == 0 {
+		return nil, err
+	}
+	return registrations[0], nil
+}
@@ -0,0 +1,64 @@
+package gorm
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ServiceRecordRepository implementa la interfaz repositories.ServiceRecordRepository usando GORM
+type ServiceRecordRepository struct {
+	db *gorm.DB
+}
+
+// NewServiceRecordRepository crea una nueva instancia de ServiceRecordRepository
+func NewServiceRecordRepository(db *gorm.DB) repositories.ServiceRecordRepository {
+	return &ServiceRecordRepository{
+		db: db,
+	}
+}
+
+// Create guarda un nuevo servicio junto con sus ítems
+func (r *ServiceRecordRepository) Create(ctx context.Context, record *entities.ServiceRecord) error {
+	return conn(ctx, r.db).Create(record).Error
+}
+
+// GetByID obtiene un servicio de un auto con sus ítems
+func (r *ServiceRecordRepository) GetByID(ctx context.Context, carID, id uuid.UUID) (*entities.ServiceRecord, error) {
+	var record entities.ServiceRecord
+	err := conn(ctx, r.db).
+		Preload("LineItems").
+		First(&record, "id = ? AND car_id = ?", id, carID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// Update actualiza un servicio reemplazando sus ítems
+func (r *ServiceRecordRepository) Update(ctx context.Context, record *entities.ServiceRecord) error {
+	db := conn(ctx, r.db)
+	if err := db.Where("service_record_id = ?", record.ID).Delete(&entities.ServiceLineItem{}).Error; err != nil {
+		return err
+	}
+	return db.Save(record).Error
+}
+
+// Delete elimina un servicio por su ID
+func (r *ServiceRecordRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&entities.ServiceRecord{}, "id = ?", id).Error
+}
+
+// ListByCarID obtiene los servicios de un auto, del más reciente al más antiguo
+func (r *ServiceRecordRepository) ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.ServiceRecord, error) {
+	var records []*entities.ServiceRecord
+	err := conn(ctx, r.db).
+		Preload("LineItems").
+		Where("car_id = ?", carID).
+		Order("date DESC, odometer DESC").
+		Find(&records).Error
+	return records, err
+}
@@ -0,0 +1,66 @@
+package gorm
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// StolenReportRepository implementa la interfaz repositories.StolenReportRepository usando GORM
+type StolenReportRepository struct {
+	db *gorm.DB
+}
+
+// NewStolenReportRepository crea una nueva instancia de StolenReportRepository
+func NewStolenReportRepository(db *gorm.DB) repositories.StolenReportRepository {
+	return &StolenReportRepository{
+		db: db,
+	}
+}
+
+// Create guarda una nueva denuncia de robo
+func (r *StolenReportRepository) Create(ctx context.Context, report *entities.StolenReport) error {
+	return conn(ctx, r.db).Create(report).Error
+}
+
+// GetByID obtiene una denuncia por su ID
+func (r *StolenReportRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.StolenReport, error) {
+	var report entities.StolenReport
+	err := conn(ctx, r.db).First(&report, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// Update actualiza una denuncia existente
+func (r *StolenReportRepository) Update(ctx context.Context, report *entities.StolenReport) error {
+	return conn(ctx, r.db).Save(report).Error
+}
+
+// GetActiveByVIN obtiene la denuncia vigente de un VIN
+func (r *StolenReportRepository) GetActiveByVIN(ctx context.Context, vin string) (*entities.StolenReport, error) {
+	var reports []*entities.StolenReport
+	err := conn(ctx, r.db).
+		Where("vin = ? AND recovered_date IS NULL", vin).
+		Order("report_date DESC").
+		Limit(1).
+		Find(&reports).Error
+	if err != nil || len(reports) == 0 {
+		return nil, err
+	}
+	return reports[0], nil
+}
+
+// ListByCarID obtiene las denuncias de un auto, de la más reciente a la más antigua
+func (r *StolenReportRepository) ListByCarID(ctx context.Context, carID uuid.UUID) ([]*entities.StolenReport, error) {
+	var reports []*entities.StolenReport
+	err := conn(ctx, r.db).
+		Where("car_id = ?", carID).
+		Order("report_date DESC").
+		Find(&reports).Error
+	return reports, err
+}
@@ -0,0 +1,69 @@
+package gorm
+
+import (
+	"car-service/internal/domain/repositories"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// ErrNoTransaction se retorna cuando se intenta confirmar o revertir un contexto sin transacción activa
+var ErrNoTransaction = errors.New("no hay una transacción activa en el contexto")
+
+type txKey struct{}
+
+// UnitOfWork implementa la interfaz repositories.UnitOfWork usando transacciones de GORM
+type UnitOfWork struct {
+	db *gorm.DB
+}
+
+// NewUnitOfWork crea una nueva instancia de UnitOfWork
+func NewUnitOfWork(db *gorm.DB) repositories.UnitOfWork {
+	return &UnitOfWork{
+		db: db,
+	}
+}
+
+// Begin inicia una transacción y la adjunta al contexto retornado
+func (u *UnitOfWork) Begin(ctx context.Context) (context.Context, error) {
+	if _, ok := transactionFrom(ctx); ok {
+		return ctx, errors.New("ya existe una transacción activa en el contexto")
+	}
+	tx := u.db.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return ctx, tx.Error
+	}
+	return context.WithValue(ctx, txKey{}, tx), nil
+}
+
+// Commit confirma la transacción adjunta al contexto
+func (u *UnitOfWork) Commit(ctx context.Context) error {
+	tx, ok := transactionFrom(ctx)
+	if !ok {
+		return ErrNoTransaction
+	}
+	return tx.Commit().Error
+}
+
+// Rollback revierte la transacción adjunta al contexto
+func (u *UnitOfWork) Rollback(ctx context.Context) error {
+	tx, ok := transactionFrom(ctx)
+	if !ok {
+		return ErrNoTransaction
+	}
+	return tx.Rollback().Error
+}
+
+func transactionFrom(ctx context.Context) (*gorm.DB, bool) {
+	tx, ok := ctx.Value(txKey{}).(*gorm.DB)
+	return tx, ok
+}
+
+// conn resuelve la conexión a utilizar: la transacción del contexto si existe o la base de datos por defecto
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := transactionFrom(ctx); ok {
+		return tx
+	}
+	return db.WithContext(ctx)
+}
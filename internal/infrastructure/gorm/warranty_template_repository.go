@@ -0,0 +1,84 @@
+package gorm
+
+import (
+	"car-service/internal/domain/entities"
+	"car-service/internal/domain/repositories"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WarrantyTemplateRepository implementa la interfaz repositories.WarrantyTemplateRepository usando GORM
+type WarrantyTemplateRepository struct {
+	db *gorm.DB
+}
+
+// NewWarrantyTemplateRepository crea una nueva instancia de WarrantyTemplateRepository
+func NewWarrantyTemplateRepository(db *gorm.DB) repositories.WarrantyTemplateRepository {
+	return &WarrantyTemplateRepository{
+		db: db,
+	}
+}
+
+// Create guarda una nueva plantilla con sus coberturas
+func (r *WarrantyTemplateRepository) Create(ctx context.Context, template *entities.WarrantyTemplate) error {
+	return conn(ctx, r.db).Create(template).Error
+}
+
+// GetByID obtiene una plantilla con su marca, su modelo y sus coberturas
+func (r *WarrantyTemplateRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.WarrantyTemplate, error) {
+	var template entities.WarrantyTemplate
+	err := conn(ctx, r.db).
+		Preload("Brand").
+		Preload("Model").
+		Preload("Coverages").
+		First(&template, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// GetByModelID obtiene la plantilla propia de un modelo
+func (r *WarrantyTemplateRepository) GetByModelID(ctx context.Context, modelID uuid.UUID) (*entities.WarrantyTemplate, error) {
+	return r.first(ctx, "model_id = ?", modelID)
+}
+
+// GetByBrandID obtiene la plantilla general de una marca
+func (r *WarrantyTemplateRepository) GetByBrandID(ctx context.Context, brandID uuid.UUID) (*entities.WarrantyTemplate, error) {
+	return r.first(ctx, "brand_id = ? AND model_id IS NULL", brandID)
+}
+
+// List obtiene todas las plantillas con su marca, su modelo y sus coberturas
+func (r *WarrantyTemplateRepository) List(ctx context.Context) ([]*entities.WarrantyTemplate, error) {
+	var templates []*entities.WarrantyTemplate
+	err := conn(ctx, r.db).
+		Preload("Brand").
+		Preload("Model").
+		Preload("Coverages").
+		Order("created_at").
+		Find(&templates).Error
+	return templates, err
+}
+
+// Delete elimina una plantilla por su ID
+func (r *WarrantyTemplateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&entities.WarrantyTemplate{}, "id = ?", id).Error
+}
+
+// first obtiene la primera plantilla que cumple la condición, o nil si no hay ninguna
+func (r *WarrantyTemplateRepository) first(ctx context.Context, query string, args ...any) (*entities.WarrantyTemplate, error) {
+	var templates []*entities.WarrantyTemplate
+	err := conn(ctx, r.db).
+		Preload("Brand").
+		Preload("Model").
+		Preload("Coverages").
+		Where(query, args...).
+		Limit(1).
+		Find(&templates).Error
+	if err != nil || len(templates) == 0 {
+		return nil, err
+	}
+	return templates[0], nil
+}
@@ -5,7 +5,6 @@ package migrations
 import (
 	"car-service/internal/domain/entities"
 	"log"
-	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -16,59 +15,45 @@ type InitialData struct{}
 
 // Up inserta los datos iniciales en la base de datos
 func (m *InitialData) Up(db *gorm.DB) error {
-	// Verificar si los datos iniciales ya fueron insertados
-	var count int64
-	db.Model(&SchemaMigration{}).Where("version = ?", "000002_initial_data").Count(&count)
-	if count > 0 {
-		log.Println("Los datos iniciales ya fueron insertados")
-		return nil
-	}
+	return applyOnce(db, "000002_initial_data", func(tx *gorm.DB) error {
+		// Crear marcas
+		toyotaID := uuid.New()
+		hondaID := uuid.New()
 
-	// Crear marcas
-	toyotaID := uuid.New()
-	hondaID := uuid.New()
+		brands := []entities.Brand{
+			{ID: toyotaID, Name: "Toyota", Country: "Japan"},
+			{ID: hondaID, Name: "Honda", Country: "Japan"},
+		}
 
-	brands := []entities.Brand{
-		{ID: toyotaID, Name: "Toyota", Country: "Japan"},
-		{ID: hondaID, Name: "Honda", Country: "Japan"},
-	}
+		if err := tx.Create(&brands).Error; err != nil {
+			return err
+		}
 
-	if err := db.Create(&brands).Error; err != nil {
-		return err
-	}
+		// Crear modelos
+		models := []entities.Model{
+			{ID: uuid.New(), Name: "Corolla", StartYear: 2024, BrandID: toyotaID, Category: "Sedan", Active: true},
+			{ID: uuid.New(), Name: "Civic", StartYear: 2024, BrandID: hondaID, Category: "Sedan", Active: true},
+		}
 
-	// Crear modelos
-	models := []entities.Model{
-		{ID: uuid.New(), Name: "Corolla", StartYear: 2024, BrandID: toyotaID, Category: "Sedan", Active: true},
-		{ID: uuid.New(), Name: "Civic", StartYear: 2024, BrandID: hondaID, Category: "Sedan", Active: true},
-	}
+		if err := tx.Create(&models).Error; err != nil {
+			return err
+		}
 
-	if err := db.Create(&models).Error; err != nil {
-		return err
-	}
+		// Crear owner root
+		rootOwner := entities.Owner{
+			ID:    uuid.New(),
+			Name:  "Root Owner",
+			Email: "root@example.com",
+			Phone: "+1234567890",
+		}
 
-	// Crear owner root
-	rootOwner := entities.Owner{
-		ID:    uuid.New(),
-		Name:  "Root Owner",
-		Email: "root@example.com",
-		Phone: "+1234567890",
-	}
+		if err := tx.Create(&rootOwner).Error; err != nil {
+			return err
+		}
 
-	if err := db.Create(&rootOwner).Error; err != nil {
-		return err
-	}
-
-	// Registrar la migración como ejecutada
-	if err := db.Create(&SchemaMigration{
-		Version:   "000002_initial_data",
-		AppliedAt: time.Now(),
-	}).Error; err != nil {
-		return err
-	}
-
-	log.Println("Datos iniciales insertados correctamente")
-	return nil
+		log.Println("Datos iniciales insertados correctamente")
+		return nil
+	})
 }
 
 // Down revierte la migración de datos iniciales
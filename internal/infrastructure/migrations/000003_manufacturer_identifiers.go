@@ -0,0 +1,55 @@
+// internal/infrastructure/migrations/000003_manufacturer_identifiers.go
+
+package migrations
+
+import (
+	"car-service/internal/domain/entities"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+// manufacturerIdentifiers son los WMI iniciales agrupados por nombre de marca
+var manufacturerIdentifiers = map[string][]string{
+	"Toyota": {"JTD", "JTE", "JTK", "JTM", "JTN", "2T1", "2T3", "4T1", "5TD", "5TF", "5YF", "8AJ", "9BR"},
+	"Honda":  {"JHM", "JHL", "1HG", "2HG", "5FN", "5J6", "19X", "93H"},
+}
+
+// ManufacturerIdentifiers representa la migración de la tabla de WMI y sus datos iniciales
+type ManufacturerIdentifiers struct{}
+
+// Up crea la tabla de WMI y asocia los códigos conocidos a las marcas existentes
+func (m *ManufacturerIdentifiers) Up(db *gorm.DB) error {
+	if err := db.AutoMigrate(&entities.ManufacturerIdentifier{}); err != nil {
+		return err
+	}
+
+	return applyOnce(db, "000003_manufacturer_identifiers", func(tx *gorm.DB) error {
+		for brandName, codes := range manufacturerIdentifiers {
+			var brand entities.Brand
+			if err := tx.Where("name = ?", brandName).First(&brand).Error; err != nil {
+				log.Printf("Marca %s no encontrada, se omiten sus WMI", brandName)
+				continue
+			}
+
+			identifiers := make([]*entities.ManufacturerIdentifier, len(codes))
+			for i, code := range codes {
+				identifiers[i] = entities.NewManufacturerIdentifier(code, brand.ID)
+			}
+			if err := tx.Create(&identifiers).Error; err != nil {
+				return err
+			}
+		}
+
+		log.Println("WMI iniciales insertados correctamente")
+		return nil
+	})
+}
+
+// Down elimina la tabla de WMI
+func (m *ManufacturerIdentifiers) Down(db *gorm.DB) error {
+	if err := db.Migrator().DropTable(&entities.ManufacturerIdentifier{}); err != nil {
+		return err
+	}
+	return removeVersion(db, "000003_manufacturer_identifiers")
+}
@@ -0,0 +1,48 @@
+// internal/infrastructure/migrations/000004_ownership_records.go
+
+package migrations
+
+import (
+	"car-service/internal/domain/entities"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+// OwnershipRecords representa la migración del historial de propietarios
+type OwnershipRecords struct{}
+
+// Up crea la tabla del historial y abre un registro vigente para cada auto existente
+func (m *OwnershipRecords) Up(db *gorm.DB) error {
+	if err := db.AutoMigrate(&entities.OwnershipRecord{}); err != nil {
+		return err
+	}
+
+	return applyOnce(db, "000004_ownership_records", func(tx *gorm.DB) error {
+		var cars []*entities.Car
+		if err := tx.Find(&cars).Error; err != nil {
+			return err
+		}
+
+		if len(cars) > 0 {
+			records := make([]*entities.OwnershipRecord, len(cars))
+			for i, car := range cars {
+				records[i] = entities.NewOwnershipRecord(car.ID, car.OwnerID, car.CreatedAt, nil, "")
+			}
+			if err := tx.Create(&records).Error; err != nil {
+				return err
+			}
+		}
+
+		log.Println("Historial de propietarios inicializado correctamente")
+		return nil
+	})
+}
+
+// Down elimina la tabla del historial de propietarios
+func (m *OwnershipRecords) Down(db *gorm.DB) error {
+	if err := db.Migrator().DropTable(&entities.OwnershipRecord{}); err != nil {
+		return err
+	}
+	return removeVersion(db, "000004_ownership_records")
+}
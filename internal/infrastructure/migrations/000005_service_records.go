@@ -0,0 +1,34 @@
+// internal/infrastructure/migrations/000005_service_records.go
+
+package migrations
+
+import (
+	"car-service/internal/domain/entities"
+
+	"gorm.io/gorm"
+)
+
+// ServiceRecords representa la migración del historial de servicios y mantenimiento
+type ServiceRecords struct{}
+
+// Up crea las tablas de servicios y de sus ítems
+func (m *ServiceRecords) Up(db *gorm.DB) error {
+	if err := db.AutoMigrate(
+		&entities.ServiceRecord{},
+		&entities.ServiceLineItem{},
+	); err != nil {
+		return err
+	}
+	return applyOnce(db, "000005_service_records", nil)
+}
+
+// Down elimina las tablas de servicios en orden inverso
+func (m *ServiceRecords) Down(db *gorm.DB) error {
+	if err := db.Migrator().DropTable(&entities.ServiceLineItem{}); err != nil {
+		return err
+	}
+	if err := db.Migrator().DropTable(&entities.ServiceRecord{}); err != nil {
+		return err
+	}
+	return removeVersion(db, "000005_service_records")
+}
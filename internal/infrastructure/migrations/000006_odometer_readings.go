@@ -0,0 +1,56 @@
+// internal/infrastructure/migrations/000006_odometer_readings.go
+
+package migrations
+
+import (
+	"car-service/internal/domain/entities"
+	"fmt"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+// OdometerReadings representa la migración del historial de kilometraje
+type OdometerReadings struct{}
+
+// Up crea la tabla de lecturas, agrega la marca de anomalías al auto y carga
+// una lectura por cada servicio registrado
+func (m *OdometerReadings) Up(db *gorm.DB) error {
+	if err := db.AutoMigrate(&entities.Car{}, &entities.OdometerReading{}); err != nil {
+		return err
+	}
+
+	return applyOnce(db, "000006_odometer_readings", func(tx *gorm.DB) error {
+		var records []*entities.ServiceRecord
+		if err := tx.Find(&records).Error; err != nil {
+			return err
+		}
+
+		if len(records) > 0 {
+			readings := make([]*entities.OdometerReading, len(records))
+			for i, record := range records {
+				readings[i] = entities.NewOdometerReading(record.CarID, record.Date, record.Odometer,
+					entities.OdometerSourceService, &record.ID, fmt.Sprintf("Servicio en %s", record.Workshop))
+			}
+			if err := tx.Create(&readings).Error; err != nil {
+				return err
+			}
+		}
+
+		log.Println("Lecturas de kilometraje cargadas correctamente")
+		return nil
+	})
+}
+
+// Down elimina la tabla de lecturas y la marca de anomalías del auto
+func (m *OdometerReadings) Down(db *gorm.DB) error {
+	if err := db.Migrator().DropTable(&entities.OdometerReading{}); err != nil {
+		return err
+	}
+	if db.Migrator().HasColumn(&entities.Car{}, "OdometerFlagged") {
+		if err := db.Migrator().DropColumn(&entities.Car{}, "OdometerFlagged"); err != nil {
+			return err
+		}
+	}
+	return removeVersion(db, "000006_odometer_readings")
+}
@@ -0,0 +1,82 @@
+// internal/infrastructure/migrations/000007_car_status.go
+
+package migrations
+
+import (
+	"car-service/internal/domain/entities"
+	"log"
+	"slices"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CarStatus representa la migración del indicador active al ciclo de vida de estados
+type CarStatus struct{}
+
+// Up agrega el estado del auto y su historial, convierte el indicador active y elimina la columna anterior.
+// Los autos activos pasan a registered y los inactivos a in_service: el indicador no distinguía una baja
+// definitiva de una inmovilización temporal, así que se usa un estado reversible y la baja se confirma
+// luego con un cambio de estado.
+func (m *CarStatus) Up(db *gorm.DB) error {
+	if err := db.AutoMigrate(&entities.Car{}, &entities.CarStatusTransition{}); err != nil {
+		return err
+	}
+
+	return applyOnce(db, "000007_car_status", func(tx *gorm.DB) error {
+		var inactive []uuid.UUID
+		if tx.Migrator().HasColumn(&entities.Car{}, "active") {
+			if err := tx.Model(&entities.Car{}).Unscoped().Where("active = false").Pluck("id", &inactive).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec("UPDATE cars SET status = ? WHERE active = false", entities.CarStatusInService).Error; err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropColumn(&entities.Car{}, "active"); err != nil {
+				return err
+			}
+		}
+
+		// Registrar el estado inicial de cada auto en el historial
+		var cars []*entities.Car
+		if err := tx.Unscoped().Find(&cars).Error; err != nil {
+			return err
+		}
+		if len(cars) > 0 {
+			transitions := make([]*entities.CarStatusTransition, len(cars))
+			for i, car := range cars {
+				reason := "Estado inicial"
+				if slices.Contains(inactive, car.ID) {
+					reason = "Estado inicial: el vehículo estaba inactivo; confirmar si corresponde darlo de baja"
+				}
+				transitions[i] = entities.NewCarStatusTransition(car.ID, "", car.Status, reason)
+			}
+			if err := tx.Create(&transitions).Error; err != nil {
+				return err
+			}
+		}
+
+		log.Println("Estados de los vehículos convertidos correctamente")
+		return nil
+	})
+}
+
+// Down restaura el indicador active a partir del estado y elimina el historial de estados
+func (m *CarStatus) Down(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&entities.Car{}, "active") {
+		if err := db.Exec("ALTER TABLE cars ADD COLUMN active boolean DEFAULT true").Error; err != nil {
+			return err
+		}
+	}
+	if err := db.Exec("UPDATE cars SET active = status NOT IN ?",
+		[]entities.CarStatus{entities.CarStatusScrapped, entities.CarStatusExported}).Error; err != nil {
+		return err
+	}
+	if err := db.Migrator().DropColumn(&entities.Car{}, "Status"); err != nil {
+		return err
+	}
+	if err := db.Migrator().DropTable(&entities.CarStatusTransition{}); err != nil {
+		return err
+	}
+	return removeVersion(db, "000007_car_status")
+}
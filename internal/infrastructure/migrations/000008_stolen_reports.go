@@ -0,0 +1,28 @@
+// internal/infrastructure/migrations/000008_stolen_reports.go
+
+package migrations
+
+import (
+	"car-service/internal/domain/entities"
+
+	"gorm.io/gorm"
+)
+
+// StolenReports representa la migración de las denuncias de robo
+type StolenReports struct{}
+
+// Up crea la tabla de denuncias de robo
+func (m *StolenReports) Up(db *gorm.DB) error {
+	if err := db.AutoMigrate(&entities.StolenReport{}); err != nil {
+		return err
+	}
+	return applyOnce(db, "000008_stolen_reports", nil)
+}
+
+// Down elimina la tabla de denuncias de robo
+func (m *StolenReports) Down(db *gorm.DB) error {
+	if err := db.Migrator().DropTable(&entities.StolenReport{}); err != nil {
+		return err
+	}
+	return removeVersion(db, "000008_stolen_reports")
+}
@@ -0,0 +1,28 @@
+// internal/infrastructure/migrations/000009_registrations.go
+
+package migrations
+
+import (
+	"car-service/internal/domain/entities"
+
+	"gorm.io/gorm"
+)
+
+// Registrations representa la migración de las patentes
+type Registrations struct{}
+
+// Up crea la tabla de patentes con el índice único parcial de patentes activas
+func (m *Registrations) Up(db *gorm.DB) error {
+	if err := db.AutoMigrate(&entities.Registration{}); err != nil {
+		return err
+	}
+	return applyOnce(db, "000009_registrations", nil)
+}
+
+// Down elimina la tabla de patentes
+func (m *Registrations) Down(db *gorm.DB) error {
+	if err := db.Migrator().DropTable(&entities.Registration{}); err != nil {
+		return err
+	}
+	return removeVersion(db, "000009_registrations")
+}
@@ -0,0 +1,28 @@
+// internal/infrastructure/migrations/000010_inspections.go
+
+package migrations
+
+import (
+	"car-service/internal/domain/entities"
+
+	"gorm.io/gorm"
+)
+
+// Inspections representa la migración de las inspecciones técnicas
+type Inspections struct{}
+
+// Up crea las tablas de inspecciones y sus defectos
+func (m *Inspections) Up(db *gorm.DB) error {
+	if err := db.AutoMigrate(&entities.Inspection{}, &entities.InspectionDefect{}); err != nil {
+		return err
+	}
+	return applyOnce(db, "000010_inspections", nil)
+}
+
+// Down elimina las tablas de inspecciones y sus defectos
+func (m *Inspections) Down(db *gorm.DB) error {
+	if err := db.Migrator().DropTable(&entities.InspectionDefect{}, &entities.Inspection{}); err != nil {
+		return err
+	}
+	return removeVersion(db, "000010_inspections")
+}
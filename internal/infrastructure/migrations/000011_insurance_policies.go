@@ -0,0 +1,28 @@
+// internal/infrastructure/migrations/000011_insurance_policies.go
+
+package migrations
+
+import (
+	"car-service/internal/domain/entities"
+
+	"gorm.io/gorm"
+)
+
+// InsurancePolicies representa la migración de las pólizas de seguro
+type InsurancePolicies struct{}
+
+// Up crea la tabla de pólizas con el índice único de número por aseguradora
+func (m *InsurancePolicies) Up(db *gorm.DB) error {
+	if err := db.AutoMigrate(&entities.InsurancePolicy{}); err != nil {
+		return err
+	}
+	return applyOnce(db, "000011_insurance_policies", nil)
+}
+
+// Down elimina la tabla de pólizas
+func (m *InsurancePolicies) Down(db *gorm.DB) error {
+	if err := db.Migrator().DropTable(&entities.InsurancePolicy{}); err != nil {
+		return err
+	}
+	return removeVersion(db, "000011_insurance_policies")
+}
@@ -0,0 +1,28 @@
+// internal/infrastructure/migrations/000012_recalls.go
+
+package migrations
+
+import (
+	"car-service/internal/domain/entities"
+
+	"gorm.io/gorm"
+)
+
+// Recalls representa la migración de las campañas de llamado a revisión
+type Recalls struct{}
+
+// Up crea las tablas de campañas y de vehículos alcanzados
+func (m *Recalls) Up(db *gorm.DB) error {
+	if err := db.AutoMigrate(&entities.Recall{}, &entities.CarRecall{}); err != nil {
+		return err
+	}
+	return applyOnce(db, "000012_recalls", nil)
+}
+
+// Down elimina las tablas de campañas en orden inverso
+func (m *Recalls) Down(db *gorm.DB) error {
+	if err := db.Migrator().DropTable(&entities.CarRecall{}, &entities.Recall{}); err != nil {
+		return err
+	}
+	return removeVersion(db, "000012_recalls")
+}
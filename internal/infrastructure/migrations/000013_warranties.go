@@ -0,0 +1,36 @@
+// internal/infrastructure/migrations/000013_warranties.go
+
+package migrations
+
+import (
+	"car-service/internal/domain/entities"
+
+	"gorm.io/gorm"
+)
+
+// Warranties representa la migración de las plantillas de garantía de fábrica
+type Warranties struct{}
+
+// Up crea las tablas de plantillas y coberturas y agrega a los servicios el grupo de
+// componentes y la marca de cobertura por garantía
+func (m *Warranties) Up(db *gorm.DB) error {
+	if err := db.AutoMigrate(&entities.WarrantyTemplate{}, &entities.WarrantyCoverage{}, &entities.ServiceRecord{}); err != nil {
+		return err
+	}
+	return applyOnce(db, "000013_warranties", nil)
+}
+
+// Down elimina las columnas agregadas a los servicios y las tablas de garantía en orden inverso
+func (m *Warranties) Down(db *gorm.DB) error {
+	for _, column := range []string{"ComponentGroup", "WarrantyCovered"} {
+		if db.Migrator().HasColumn(&entities.ServiceRecord{}, column) {
+			if err := db.Migrator().DropColumn(&entities.ServiceRecord{}, column); err != nil {
+				return err
+			}
+		}
+	}
+	if err := db.Migrator().DropTable(&entities.WarrantyCoverage{}, &entities.WarrantyTemplate{}); err != nil {
+		return err
+	}
+	return removeVersion(db, "000013_warranties")
+}
@@ -0,0 +1,33 @@
+// internal/infrastructure/migrations/000014_model_trims.go
+
+package migrations
+
+import (
+	"car-service/internal/domain/entities"
+
+	"gorm.io/gorm"
+)
+
+// ModelTrims representa la migración de las versiones de los modelos
+type ModelTrims struct{}
+
+// Up crea la tabla de versiones con su ficha técnica y agrega la versión a los autos
+func (m *ModelTrims) Up(db *gorm.DB) error {
+	if err := db.AutoMigrate(&entities.ModelTrim{}, &entities.Car{}); err != nil {
+		return err
+	}
+	return applyOnce(db, "000014_model_trims", nil)
+}
+
+// Down quita la versión de los autos y elimina la tabla de versiones
+func (m *ModelTrims) Down(db *gorm.DB) error {
+	if db.Migrator().HasColumn(&entities.Car{}, "TrimID") {
+		if err := db.Migrator().DropColumn(&entities.Car{}, "TrimID"); err != nil {
+			return err
+		}
+	}
+	if err := db.Migrator().DropTable(&entities.ModelTrim{}); err != nil {
+		return err
+	}
+	return removeVersion(db, "000014_model_trims")
+}
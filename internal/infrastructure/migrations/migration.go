@@ -3,6 +3,7 @@
 package migrations
 
 import (
+	"log"
 	"time"
 
 	"gorm.io/gorm"
@@ -19,3 +20,33 @@ type Migration interface {
 	Up(db *gorm.DB) error
 	Down(db *gorm.DB) error
 }
+
+// applyOnce ejecuta apply solo si la versión todavía no fue registrada y registra la
+// versión en la misma transacción, para que la migración no se repita en cada inicio
+func applyOnce(db *gorm.DB, version string, apply func(tx *gorm.DB) error) error {
+	var count int64
+	if err := db.Model(&SchemaMigration{}).Where("version = ?", version).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		log.Printf("La migración %s ya fue aplicada", version)
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		if apply != nil {
+			if err := apply(tx); err != nil {
+				return err
+			}
+		}
+		return tx.Create(&SchemaMigration{
+			Version:   version,
+			AppliedAt: time.Now(),
+		}).Error
+	})
+}
+
+// removeVersion elimina el registro de la versión al revertir la migración
+func removeVersion(db *gorm.DB, version string) error {
+	return db.Where("version = ?", version).Delete(&SchemaMigration{}).Error
+}
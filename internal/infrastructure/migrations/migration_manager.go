@@ -11,6 +11,18 @@ func Migrate(db *gorm.DB) error {
 	migrations := []Migration{
 		&InitialMigration{},
 		&InitialData{},
+		&ManufacturerIdentifiers{},
+		&OwnershipRecords{},
+		&ServiceRecords{},
+		&OdometerReadings{},
+		&CarStatus{},
+		&StolenReports{},
+		&Registrations{},
+		&Inspections{},
+		&InsurancePolicies{},
+		&Recalls{},
+		&Warranties{},
+		&ModelTrims{},
 	}
 
 	for _, migration := range migrations {
@@ -24,9 +36,22 @@ func Migrate(db *gorm.DB) error {
 
 // Rollback revierte la última migración
 func Rollback(db *gorm.DB) error {
+	// Se listan en el mismo orden que en Migrate y se revierten desde la última
 	migrations := []Migration{
-		&InitialData{},
 		&InitialMigration{},
+		&InitialData{},
+		&ManufacturerIdentifiers{},
+		&OwnershipRecords{},
+		&ServiceRecords{},
+		&OdometerReadings{},
+		&CarStatus{},
+		&StolenReports{},
+		&Registrations{},
+		&Inspections{},
+		&InsurancePolicies{},
+		&Recalls{},
+		&Warranties{},
+		&ModelTrims{},
 	}
 
 	for i := len(migrations) - 1; i >= 0; i-- {
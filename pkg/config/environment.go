@@ -24,6 +24,9 @@ type Environment struct {
 	// Application configs
 	Environment string
 	LogLevel    string
+
+	// Domain configs
+	VINCheckDigitScope string
 }
 
 // LoadEnv carga las variables de entorno desde el archivo .env si existe
@@ -65,6 +68,9 @@ func NewEnvironment() (*Environment, error) {
 		// Application configs
 		Environment: getEnvOrDefault("APP_ENV", "development"),
 		LogLevel:    getEnvOrDefault("LOG_LEVEL", "info"),
+
+		// Domain configs
+		VINCheckDigitScope: getEnvOrDefault("VIN_CHECK_DIGIT_SCOPE", "all"),
 	}, nil
 }
 
@@ -87,4 +93,4 @@ func getEnvOrDefault(key, defaultValue string) string {
 		return value
 	}
 	return defaultValue
-} 
\ No newline at end of file
+}